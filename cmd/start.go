@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"time"
 
 	"github.com/keep-network/keep-core/pkg/diagnostics"
@@ -43,9 +42,6 @@ const startDescription = `Starts the Keep client in the foreground. Currently th
    threshold relay client for the Keep random beacon.`
 
 // Values related with balance monitoring.
-// defaultBalanceAlertThreshold determines the alert threshold below which
-// the alert should be triggered.
-var defaultBalanceAlertThreshold = big.NewInt(500000000000000000) // 0.5 ether
 // defaultBalanceMonitoringTick determines how often the monitoring
 // check should be triggered.
 const defaultBalanceMonitoringTick = 10 * time.Minute
@@ -161,6 +157,7 @@ func Start(c *cli.Context) error {
 		chainProvider,
 		netProvider,
 		persistence,
+		config.Beacon.MaxConcurrentSigning,
 	)
 	if err != nil {
 		return fmt.Errorf("error initializing beacon: [%v]", err)
@@ -168,7 +165,7 @@ func Start(c *cli.Context) error {
 
 	initializeMetrics(ctx, config, netProvider, stakeMonitor, ethereumKey.Address.Hex())
 	initializeDiagnostics(ctx, config, netProvider)
-	initializeBalanceMonitoring(ctx, chainProvider, config, ethereumKey.Address.Hex())
+	initializeBalanceMonitoring(ctx, chainProvider)
 
 	select {
 	case <-ctx.Done():
@@ -266,8 +263,6 @@ func initializeDiagnostics(
 func initializeBalanceMonitoring(
 	ctx context.Context,
 	chainProvider chain.Handle,
-	config *config.Config,
-	ethereumAddress string,
 ) {
 	balanceMonitor, err := chainProvider.BalanceMonitor()
 	if err != nil {
@@ -275,22 +270,12 @@ func initializeBalanceMonitoring(
 		return
 	}
 
-	alertThreshold := defaultBalanceAlertThreshold
-	if config.Ethereum.BalanceAlertThreshold != nil {
-		alertThreshold = config.Ethereum.BalanceAlertThreshold.Int
-	}
-
+	// The alert threshold is taken from the chain config and the observed
+	// address is the operator address derived from its static key.
 	balanceMonitor.Observe(
 		ctx,
-		ethereumAddress,
-		alertThreshold,
+		"",
+		nil,
 		defaultBalanceMonitoringTick,
 	)
-
-	logger.Infof(
-		"started balance monitoring for address [%v] "+
-			"with the alert threshold set to [%v] wei",
-		ethereumAddress,
-		alertThreshold,
-	)
 }
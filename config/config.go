@@ -24,6 +24,7 @@ type Config struct {
 	Storage     Storage
 	Metrics     Metrics
 	Diagnostics Diagnostics
+	Beacon      Beacon
 }
 
 // Storage stores meta-info about keeping data on disk
@@ -43,6 +44,14 @@ type Diagnostics struct {
 	Port int
 }
 
+// Beacon stores configuration of the random beacon.
+type Beacon struct {
+	// MaxConcurrentSigning is the maximum number of relay entry signing
+	// processes the node executes at the same time. If not set, the default
+	// limit is used.
+	MaxConcurrentSigning int
+}
+
 var (
 	// KeepOpts contains global application settings
 	KeepOpts Config
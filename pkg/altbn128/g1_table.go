@@ -0,0 +1,112 @@
+package altbn128
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+// g1TableWindowBits is the size of the window, in bits, used by G1Table.
+// Each window holds `2^w` precomputed points.
+const g1TableWindowBits = 4
+
+// g1MarshalledSize is the size of a marshalled G1 point.
+const g1MarshalledSize = 64
+
+// G1Table holds precomputed multiples of a fixed G1 base point so that
+// a scalar multiplication of that point can be computed with point additions
+// only. For each `w`-bit window `i` of a scalar, the table holds points
+// `j * 2^(w*i) * P + P` for `j` in `[0, 2^w)`.
+//
+// Scalars multiplied with the table are usually secret, so the multiplication
+// does not depend on their value: exactly one entry is added for each window
+// and the entry is looked up in constant time. The base point is added to
+// each entry so that none of them is the point at infinity, including the one
+// for zero windows. Since the base point has a prime order, `j * 2^(w*i) + 1`
+// is never a multiple of it. The base point added to each entry is subtracted
+// from the final sum.
+//
+// The table is never modified once constructed so it can be shared between
+// goroutines computing multiples of the same base point.
+type G1Table struct {
+	// Marshalled entries, so they can be looked up in constant time.
+	windows [][][]byte
+	// `-windowsCount * P`, compensating the base point added to each entry.
+	offset *bn256.G1
+}
+
+// NewG1Table precomputes a table of multiples of the provided base point.
+func NewG1Table(base *bn256.G1) *G1Table {
+	windowSize := 1 << g1TableWindowBits
+	windowsCount := (bn256.Order.BitLen() + g1TableWindowBits - 1) /
+		g1TableWindowBits
+
+	windows := make([][][]byte, windowsCount)
+
+	windowBase := new(bn256.G1).Set(base) // 2^(w*i) * P
+	for i := range windows {
+		windows[i] = make([][]byte, windowSize)
+
+		entry := new(bn256.G1).Set(base) // j * 2^(w*i) * P + P
+		for j := 0; j < windowSize; j++ {
+			windows[i][j] = entry.Marshal()
+			entry = new(bn256.G1).Add(entry, windowBase)
+		}
+
+		// 2^(w*(i+1)) * P = (2^w * 2^(w*i) * P + P) - P
+		windowBase = new(bn256.G1).Add(entry, new(bn256.G1).Neg(base))
+	}
+
+	offset := new(bn256.G1).ScalarMult(base, big.NewInt(int64(windowsCount)))
+	offset.Neg(offset)
+
+	return &G1Table{windows, offset}
+}
+
+// ScalarMult returns `k * P`, where `P` is the base point of the table.
+// The scalar is reduced modulo the order of the group before multiplication.
+func (t *G1Table) ScalarMult(k *big.Int) *bn256.G1 {
+	scalar := new(big.Int).Mod(k, bn256.Order)
+
+	result := new(bn256.G1).Set(t.offset)
+	entryBytes := make([]byte, g1MarshalledSize)
+	entry := new(bn256.G1)
+	for i, window := range t.windows {
+		lookupEntry(window, windowValue(scalar, i*g1TableWindowBits), entryBytes)
+
+		if _, err := entry.Unmarshal(entryBytes); err != nil {
+			// Entries are marshalled by NewG1Table, so this can not happen
+			// unless the table has been corrupted.
+			panic(fmt.Sprintf("invalid G1 table entry: [%v]", err))
+		}
+
+		result.Add(result, entry)
+	}
+
+	return result
+}
+
+// lookupEntry copies the entry with the given index into the destination.
+// All entries of the window are read so that the memory access pattern does
+// not depend on the index.
+func lookupEntry(window [][]byte, index int, destination []byte) {
+	for j, entry := range window {
+		subtle.ConstantTimeCopy(
+			subtle.ConstantTimeEq(int32(j), int32(index)),
+			destination,
+			entry,
+		)
+	}
+}
+
+// windowValue returns the value of `g1TableWindowBits` bits of the scalar
+// starting from the given bit offset.
+func windowValue(scalar *big.Int, offset int) int {
+	value := 0
+	for bit := g1TableWindowBits - 1; bit >= 0; bit-- {
+		value = value<<1 | int(scalar.Bit(offset+bit))
+	}
+	return value
+}
@@ -0,0 +1,85 @@
+package altbn128
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+func TestG1TableScalarMult(t *testing.T) {
+	base := G1HashToPoint([]byte("base"))
+	table := NewG1Table(base)
+
+	random, err := rand.Int(rand.Reader, bn256.Order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		scalar *big.Int
+	}{
+		"zero": {
+			scalar: big.NewInt(0),
+		},
+		"one": {
+			scalar: big.NewInt(1),
+		},
+		"single window": {
+			scalar: big.NewInt(15),
+		},
+		"two windows": {
+			scalar: big.NewInt(16),
+		},
+		"random": {
+			scalar: random,
+		},
+		"order minus one": {
+			scalar: new(big.Int).Sub(bn256.Order, big.NewInt(1)),
+		},
+		"order": {
+			scalar: bn256.Order,
+		},
+		"greater than order": {
+			scalar: new(big.Int).Add(bn256.Order, big.NewInt(7)),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			expected := new(bn256.G1).ScalarMult(base, test.scalar)
+			actual := table.ScalarMult(test.scalar)
+
+			if expected.String() != actual.String() {
+				t.Fatalf(
+					"unexpected point\nexpected: %v\nactual:   %v",
+					expected,
+					actual,
+				)
+			}
+		})
+	}
+}
+
+func TestG1TableScalarBaseMult(t *testing.T) {
+	table := NewG1Table(new(bn256.G1).ScalarBaseMult(big.NewInt(1)))
+
+	for i := 0; i < 10; i++ {
+		scalar, err := rand.Int(rand.Reader, bn256.Order)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := new(bn256.G1).ScalarBaseMult(scalar)
+		actual := table.ScalarMult(scalar)
+
+		if expected.String() != actual.String() {
+			t.Fatalf(
+				"unexpected point\nexpected: %v\nactual:   %v",
+				expected,
+				actual,
+			)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package beacon
 
 import (
 	"context"
+	crand "crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"sync"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay"
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/groupselection"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -20,19 +23,39 @@ import (
 
 var logger = log.Logger("keep-beacon")
 
+// defaultMaxConcurrentSigning is the maximum number of signing processes the
+// node executes at the same time if no limit has been configured.
+const defaultMaxConcurrentSigning = 64
+
+// relayRequestHandlerTimeout is the maximum time the relay request queue waits
+// for a request to be handled before it dispatches the next request.
+const relayRequestHandlerTimeout = 5 * time.Second
+
 // Initialize kicks off the random beacon by initializing internal state,
 // ensuring preconditions like staking are met, and then kicking off the
 // internal random beacon implementation. Returns an error if this failed,
-// otherwise enters a blocked loop.
+// otherwise enters a blocked loop. At most maxConcurrentSigning signing
+// processes are executed at the same time; if it is not a positive number,
+// the default limit is used.
 func Initialize(
 	ctx context.Context,
 	stakingID string,
 	chainHandle chain.Handle,
 	netProvider net.Provider,
 	persistence persistence.Handle,
+	maxConcurrentSigning int,
 ) error {
+	// Secrets generated during the key generation must not be weak, so the
+	// node does not participate at all if the random source looks broken.
+	if err := gjkr.CheckEntropy(crand.Reader); err != nil {
+		return fmt.Errorf("random source entropy check failed: [%w]", err)
+	}
+
 	relayChain := chainHandle.ThresholdRelay()
 	chainConfig := relayChain.GetConfig()
+	if err := chainConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid relay chain config: [%w]", err)
+	}
 
 	stakeMonitor, err := chainHandle.StakeMonitor()
 	if err != nil {
@@ -54,12 +77,17 @@ func Initialize(
 	groupRegistry := registry.NewGroupRegistry(relayChain, persistence)
 	groupRegistry.LoadExistingGroups()
 
+	if maxConcurrentSigning <= 0 {
+		maxConcurrentSigning = defaultMaxConcurrentSigning
+	}
+
 	node := relay.NewNode(
 		staker,
 		netProvider,
 		blockCounter,
 		chainConfig,
 		groupRegistry,
+		maxConcurrentSigning,
 	)
 
 	pendingGroupSelections := &event.GroupSelectionTrack{
@@ -74,8 +102,10 @@ func Initialize(
 
 	node.ResumeSigningIfEligible(relayChain, signing)
 
-	_ = relayChain.OnRelayEntryRequested(func(request *event.Request) {
+	relayRequestQueue := newRelayRequestQueue(func(request *event.Request) {
 		onConfirmed := func() {
+			node.ObserveRelayEntry(request.PreviousEntry)
+
 			if node.IsInGroup(request.GroupPublicKey) {
 				go func() {
 					previousEntry := hex.EncodeToString(request.PreviousEntry[:])
@@ -128,6 +158,11 @@ func Initialize(
 			currentRelayRequestConfirmationRetries,
 			currentRelayRequestConfirmationDelay,
 		)
+	}, relayRequestHandlerTimeout, chainConfig.RelayEntryTimeout)
+	relayRequestQueue.start(ctx)
+
+	_ = relayChain.OnRelayEntryRequested(func(request *event.Request) {
+		relayRequestQueue.enqueue(request, request.BlockNumber)
 	})
 
 	_ = relayChain.OnGroupSelectionStarted(func(event *event.GroupSelectionStart) {
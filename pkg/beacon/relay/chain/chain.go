@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
@@ -86,6 +87,14 @@ type GroupRegistrationInterface interface {
 	// GetGroupMembers returns `GroupSize` slice of addresses of
 	// participants which have been selected to the group with given public key.
 	GetGroupMembers(groupPublicKey []byte) ([]StakerAddress, error)
+	// IsGroupMemberActive checks if the member with the given index in the
+	// group with the given public key is still eligible to work for the group.
+	// A member is no longer active once its operator has been slashed below
+	// the minimum stake or otherwise removed from the staking contract.
+	IsGroupMemberActive(
+		groupPublicKey []byte,
+		memberIndex GroupMemberIndex,
+	) (bool, error)
 }
 
 // GroupInterface defines the subset of the relay chain interface that pertains
@@ -162,6 +171,16 @@ type Config struct {
 	// entry to be published by the selected group. Blocks are
 	// counted from the moment relay request occur.
 	RelayEntryTimeout uint64
+	// RelayEntryConfirmationDepth is the number of blocks that has to be
+	// mined on top of the block with submitted relay entry before the
+	// submitter checks if the entry is still on the canonical chain and
+	// resubmits it if it has been dropped by a chain reorganization.
+	// Zero disables the check.
+	RelayEntryConfirmationDepth uint64
+	// MaxAccusations is the maximum number of distinct members which may be
+	// accused in phase 4 or phase 8 of the key generation before it is
+	// aborted. Zero means the limit is equal to the dishonest threshold.
+	MaxAccusations int
 }
 
 // DishonestThreshold is the maximum number of misbehaving participants for
@@ -170,3 +189,45 @@ type Config struct {
 func (c *Config) DishonestThreshold() int {
 	return c.GroupSize - c.HonestThreshold
 }
+
+// Validate checks whether the group parameters allow the group to operate.
+// The group secret of a misbehaving member is reconstructed by the honest
+// members, so there has to be more honest members than the dishonest
+// threshold, that is GroupSize - DishonestThreshold >= DishonestThreshold + 1.
+// Otherwise, the group would not be able to recover from the maximum number
+// of misbehaving members it is supposed to tolerate.
+func (c *Config) Validate() error {
+	if c.GroupSize < 1 {
+		return fmt.Errorf("group size [%v] must be positive", c.GroupSize)
+	}
+
+	if c.HonestThreshold < 1 || c.HonestThreshold > c.GroupSize {
+		return fmt.Errorf(
+			"honest threshold [%v] must be in range [1, %v]",
+			c.HonestThreshold,
+			c.GroupSize,
+		)
+	}
+
+	if c.GroupSize-c.DishonestThreshold() < c.DishonestThreshold()+1 {
+		return fmt.Errorf(
+			"group of size [%v] with dishonest threshold [%v] leaves "+
+				"[%v] honest members while at least [%v] are required "+
+				"to reconstruct secrets of misbehaving members",
+			c.GroupSize,
+			c.DishonestThreshold(),
+			c.GroupSize-c.DishonestThreshold(),
+			c.DishonestThreshold()+1,
+		)
+	}
+
+	if c.MaxAccusations < 0 || c.MaxAccusations > c.GroupSize {
+		return fmt.Errorf(
+			"maximum accusations [%v] must be in range [0, %v]",
+			c.MaxAccusations,
+			c.GroupSize,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"testing"
+)
+
+func TestConfigValidate(t *testing.T) {
+	var tests = map[string]struct {
+		groupSize       int
+		honestThreshold int
+		maxAccusations  int
+		expectedValid   bool
+	}{
+		"production group": {
+			groupSize:       64,
+			honestThreshold: 33,
+			expectedValid:   true,
+		},
+		"honest majority of one": {
+			groupSize:       5,
+			honestThreshold: 3,
+			expectedValid:   true,
+		},
+		"all members honest": {
+			groupSize:       3,
+			honestThreshold: 3,
+			expectedValid:   true,
+		},
+		"single member group": {
+			groupSize:       1,
+			honestThreshold: 1,
+			expectedValid:   true,
+		},
+		"honest members equal to dishonest threshold": {
+			groupSize:       64,
+			honestThreshold: 32,
+			expectedValid:   false,
+		},
+		"honest minority": {
+			groupSize:       5,
+			honestThreshold: 2,
+			expectedValid:   false,
+		},
+		"honest threshold of one": {
+			groupSize:       3,
+			honestThreshold: 1,
+			expectedValid:   false,
+		},
+		"zero honest threshold": {
+			groupSize:       5,
+			honestThreshold: 0,
+			expectedValid:   false,
+		},
+		"honest threshold greater than group size": {
+			groupSize:       5,
+			honestThreshold: 6,
+			expectedValid:   false,
+		},
+		"empty group": {
+			groupSize:       0,
+			honestThreshold: 0,
+			expectedValid:   false,
+		},
+		"maximum accusations equal to group size": {
+			groupSize:       5,
+			honestThreshold: 3,
+			maxAccusations:  5,
+			expectedValid:   true,
+		},
+		"maximum accusations greater than group size": {
+			groupSize:       5,
+			honestThreshold: 3,
+			maxAccusations:  6,
+			expectedValid:   false,
+		},
+		"negative maximum accusations": {
+			groupSize:       5,
+			honestThreshold: 3,
+			maxAccusations:  -1,
+			expectedValid:   false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config := &Config{
+				GroupSize:       test.groupSize,
+				HonestThreshold: test.honestThreshold,
+				MaxAccusations:  test.maxAccusations,
+			}
+
+			err := config.Validate()
+			if test.expectedValid != (err == nil) {
+				t.Errorf(
+					"unexpected validation result\nexpected valid: %v\nactual error:   %v",
+					test.expectedValid,
+					err,
+				)
+			}
+		})
+	}
+}
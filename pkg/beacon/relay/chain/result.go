@@ -3,6 +3,7 @@ package chain
 import (
 	"bytes"
 	"fmt"
+	"sort"
 )
 
 // DKGResult is a result of distributed key generation protocol.
@@ -47,6 +48,30 @@ func (r *DKGResult) Equals(r2 *DKGResult) bool {
 	return true
 }
 
+// Canonical returns a copy of the DKG result in the canonical form which
+// should be used for hashing and submitting the result to the chain. Members
+// agreeing on the result semantically must produce byte-identical canonical
+// results, otherwise their result hashes diverge.
+//
+// In the canonical form misbehaved members indexes are sorted in ascending
+// order. Group public key is left unchanged as it is already serialized to
+// the fixed-width compressed form of G2 point.
+func (r *DKGResult) Canonical() *DKGResult {
+	groupPublicKey := make([]byte, len(r.GroupPublicKey))
+	copy(groupPublicKey, r.GroupPublicKey)
+
+	misbehaved := make([]byte, len(r.Misbehaved))
+	copy(misbehaved, r.Misbehaved)
+	sort.Slice(misbehaved, func(i, j int) bool {
+		return misbehaved[i] < misbehaved[j]
+	})
+
+	return &DKGResult{
+		GroupPublicKey: groupPublicKey,
+		Misbehaved:     misbehaved,
+	}
+}
+
 // DKGResultHashFromBytes converts bytes slice to DKG Result Hash. It requires
 // provided bytes slice size to be exactly 32 bytes.
 func DKGResultHashFromBytes(bytes []byte) (DKGResultHash, error) {
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -65,3 +66,39 @@ func TestDKGResultEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestDKGResultCanonical(t *testing.T) {
+	result1 := &DKGResult{
+		GroupPublicKey: []byte{0x10, 0x20, 0x30},
+		Misbehaved:     []byte{0x01, 0x07, 0x03},
+	}
+	result2 := &DKGResult{
+		GroupPublicKey: []byte{0x10, 0x20, 0x30},
+		Misbehaved:     []byte{0x07, 0x03, 0x01},
+	}
+
+	expectedResult := &DKGResult{
+		GroupPublicKey: []byte{0x10, 0x20, 0x30},
+		Misbehaved:     []byte{0x01, 0x03, 0x07},
+	}
+
+	for _, result := range []*DKGResult{result1, result2} {
+		canonicalResult := result.Canonical()
+		if !reflect.DeepEqual(expectedResult, canonicalResult) {
+			t.Errorf(
+				"unexpected canonical result\nexpected: %v\nactual:   %v\n",
+				expectedResult,
+				canonicalResult,
+			)
+		}
+	}
+
+	expectedMisbehaved := []byte{0x01, 0x07, 0x03}
+	if !reflect.DeepEqual(expectedMisbehaved, result1.Misbehaved) {
+		t.Errorf(
+			"original result should not be modified\nexpected: %v\nactual:   %v\n",
+			expectedMisbehaved,
+			result1.Misbehaved,
+		)
+	}
+}
@@ -0,0 +1,49 @@
+package relay
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/altbn128"
+)
+
+// Each protocol session communicates over a broadcast channel dedicated to
+// that session so that messages of different sessions never cross-talk:
+//
+// - DKG is executed over a temporary channel named after the group selection
+//   seed, the hexadecimal representation of the relay entry which triggered
+//   the group selection. Each group selection has a different seed.
+// - Relay entry signing is executed over a channel named after the group,
+//   the hexadecimal representation of the compressed group public key.
+//
+// Both names are the same for all clients and must not change between client
+// versions, otherwise clients of different versions would not hear each other.
+// DKG channel names are never longer than 64 characters and group channel
+// names are always 128 characters long, so they never collide.
+
+// dkgChannelName returns the name of the broadcast channel used to execute
+// DKG for the group selected with the given seed.
+func dkgChannelName(seed *big.Int) string {
+	return seed.Text(16)
+}
+
+// groupChannelName returns the name of the broadcast channel of the group
+// with the given compressed group public key.
+func groupChannelName(compressedGroupPublicKey []byte) string {
+	return hex.EncodeToString(compressedGroupPublicKey)
+}
+
+// groupChannelNameForPublicKey returns the name of the broadcast channel of
+// the group with the given group public key, represented by marshalled G2
+// point.
+func groupChannelNameForPublicKey(groupPublicKey []byte) (string, error) {
+	g2 := new(bn256.G2)
+
+	if _, err := g2.Unmarshal(groupPublicKey); err != nil {
+		return "", fmt.Errorf("could not create channel name: [%v]", err)
+	}
+
+	return groupChannelName(altbn128.G2Point{G2: g2}.Compress()), nil
+}
@@ -0,0 +1,79 @@
+package relay
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/entry"
+	"github.com/keep-network/keep-core/pkg/net"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+)
+
+func TestGroupChannelsIsolation(t *testing.T) {
+	groupPublicKeyA := new(bn256.G2).ScalarBaseMult(big.NewInt(101)).Marshal()
+	groupPublicKeyB := new(bn256.G2).ScalarBaseMult(big.NewInt(102)).Marshal()
+
+	channelNameA, err := groupChannelNameForPublicKey(groupPublicKeyA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	channelNameB, err := groupChannelNameForPublicKey(groupPublicKeyB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if channelNameA == channelNameB {
+		t.Fatalf("channel names of different groups are the same")
+	}
+	if dkgChannelName(big.NewInt(101)) == channelNameA {
+		t.Fatalf("channel names of DKG and group are the same")
+	}
+
+	provider := netLocal.Connect()
+
+	channelA, err := provider.BroadcastChannelFor(channelNameA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	channelB, err := provider.BroadcastChannelFor(channelNameB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry.RegisterUnmarshallers(channelA)
+	entry.RegisterUnmarshallers(channelB)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancelCtx()
+
+	receivedOnA := make(chan net.Message, 1)
+	channelA.Recv(ctx, func(message net.Message) {
+		receivedOnA <- message
+	})
+	receivedOnB := make(chan net.Message, 1)
+	channelB.Recv(ctx, func(message net.Message) {
+		receivedOnB <- message
+	})
+
+	err = channelA.Send(ctx, entry.NewSignatureShareMessage(1, []byte{1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-receivedOnA:
+	case <-ctx.Done():
+		t.Fatal("message not received on the channel of group A")
+	}
+
+	select {
+	case message := <-receivedOnB:
+		t.Errorf(
+			"message sent for group A received on the channel of group B: [%v]",
+			message.Payload(),
+		)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
@@ -2,6 +2,8 @@ package dkg
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -18,38 +20,74 @@ import (
 
 var logger = log.Logger("keep-dkg")
 
-// ExecuteDKG runs the full distributed key generation lifecycle.
+// ErrBelowMinimumStake is returned when the operator refuses to participate
+// in DKG because its stake is below the minimum stake.
+var ErrBelowMinimumStake = errors.New("stake below minimum stake")
+
+// ExecuteDKG runs the full distributed key generation lifecycle. Staker with
+// stake below the minimum stake does not take part in the key generation and
+// an error wrapping ErrBelowMinimumStake is returned. Key generation is
+// aborted if more than maxAccusations distinct members are accused; zero means
+// the limit is equal to the dishonest threshold.
 func ExecuteDKG(
 	seed *big.Int,
 	index uint8, // starts with 0
 	groupSize int,
 	dishonestThreshold int,
+	maxAccusations int,
 	membershipValidator group.MembershipValidator,
 	startBlockHeight uint64,
 	blockCounter chain.BlockCounter,
 	relayChain relayChain.Interface,
 	signing chain.Signing,
+	staker chain.Staker,
 	channel net.BroadcastChannel,
 ) (*ThresholdSigner, error) {
 	// The staker index should begin with 1
 	playerIndex := group.MemberIndex(index + 1)
 
+	if err := checkMinimumStake(staker, relayChain); err != nil {
+		if errors.Is(err, ErrBelowMinimumStake) {
+			logger.Warningf(
+				"[member:%v] refusing to participate in DKG: [%v]",
+				playerIndex,
+				err,
+			)
+			return nil, err
+		}
+
+		// The stake could not be confirmed to be below the minimum, most
+		// likely because of a chain connectivity problem. The member has
+		// been selected to the group, so it takes part in DKG anyway.
+		logger.Warningf(
+			"[member:%v] could not check minimum stake; "+
+				"participating in DKG: [%v]",
+			playerIndex,
+			err,
+		)
+	}
+
 	gjkr.RegisterUnmarshallers(channel)
 	dkgResult.RegisterUnmarshallers(channel)
 
 	gjkrResult, gjkrEndBlockHeight, err := gjkr.Execute(
+		context.Background(),
 		playerIndex,
 		groupSize,
 		blockCounter,
 		channel,
 		dishonestThreshold,
+		maxAccusations,
 		seed,
 		membershipValidator,
+		signing,
 		startBlockHeight,
+		nil,
+		nil,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"[member:%v] GJKR execution failed [%v]",
+			"[member:%v] GJKR execution failed [%w]",
 			playerIndex,
 			err,
 		)
@@ -106,9 +144,42 @@ func ExecuteDKG(
 		groupPublicKey:       gjkrResult.GroupPublicKey,
 		groupPrivateKeyShare: gjkrResult.GroupPrivateKeyShare,
 		groupPublicKeyShares: gjkrResult.GroupPublicKeyShares(),
+		honestThreshold:      gjkrResult.PolynomialDegree + 1,
 	}, nil
 }
 
+// checkMinimumStake returns an error wrapping ErrBelowMinimumStake if the stake
+// of the staker is below the minimum stake. Staker below the minimum stake
+// could be selected to the group but would not be eligible for work selection,
+// so there is no point in generating a group key share for it. If the stake or
+// the minimum stake could not be read, a different error is returned.
+func checkMinimumStake(
+	staker chain.Staker,
+	relayChain relayChain.Interface,
+) error {
+	availableStake, err := staker.Stake()
+	if err != nil {
+		return fmt.Errorf("could not get stake: [%v]", err)
+	}
+
+	minimumStake, err := relayChain.MinimumStake()
+	if err != nil {
+		return fmt.Errorf("could not get minimum stake: [%v]", err)
+	}
+
+	if availableStake.Cmp(minimumStake) < 0 {
+		return fmt.Errorf(
+			"%w: staker [0x%x] has stake [%v]; minimum stake is [%v]",
+			ErrBelowMinimumStake,
+			staker.Address(),
+			availableStake,
+			minimumStake,
+		)
+	}
+
+	return nil
+}
+
 // decideMemberFate decides what the member will do in case it failed
 // publishing its DKG result. Member can stay in the group if it
 // supports the same group public key as the one registered on-chain and
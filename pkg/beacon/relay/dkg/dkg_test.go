@@ -1,12 +1,14 @@
 package dkg
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
@@ -144,3 +146,102 @@ func TestDecideMemberFate_Timeout(t *testing.T) {
 		)
 	}
 }
+
+func TestCheckMinimumStake(t *testing.T) {
+	setup()
+
+	// Minimum stake of the local chain is 10.
+	var tests = map[string]struct {
+		stake         *big.Int
+		stakeErr      error
+		expectedError error
+	}{
+		"stake above minimum stake": {
+			stake:         big.NewInt(11),
+			expectedError: nil,
+		},
+		"stake equal to minimum stake": {
+			stake:         big.NewInt(10),
+			expectedError: nil,
+		},
+		"stake below minimum stake": {
+			stake:         big.NewInt(9),
+			expectedError: ErrBelowMinimumStake,
+		},
+		"stake could not be read": {
+			stakeErr: fmt.Errorf("connection refused"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := checkMinimumStake(
+				&stubStaker{
+					address:  []byte{0x01},
+					stake:    test.stake,
+					stakeErr: test.stakeErr,
+				},
+				localChain.ThresholdRelay(),
+			)
+			if test.stakeErr != nil {
+				if err == nil || errors.Is(err, ErrBelowMinimumStake) {
+					t.Errorf(
+						"unexpected error\nexpected: stake lookup error\nactual:   %v\n",
+						err,
+					)
+				}
+				return
+			}
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestExecuteDKG_BelowMinimumStake(t *testing.T) {
+	setup()
+
+	signer, err := ExecuteDKG(
+		big.NewInt(1),
+		0,
+		5,
+		2,
+		0,
+		nil,
+		0,
+		blockCounter,
+		localChain.ThresholdRelay(),
+		localChain.Signing(),
+		&stubStaker{address: []byte{0x01}, stake: big.NewInt(9)},
+		nil,
+	)
+	if !errors.Is(err, ErrBelowMinimumStake) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			ErrBelowMinimumStake,
+			err,
+		)
+	}
+	if signer != nil {
+		t.Errorf("unexpected threshold signer for staker below minimum stake")
+	}
+}
+
+type stubStaker struct {
+	address  relayChain.StakerAddress
+	stake    *big.Int
+	stakeErr error
+}
+
+func (ss *stubStaker) Address() relayChain.StakerAddress {
+	return ss.address
+}
+
+func (ss *stubStaker) Stake() (*big.Int, error) {
+	return ss.stake, ss.stakeErr
+}
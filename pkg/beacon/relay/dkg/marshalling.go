@@ -11,11 +11,15 @@ import (
 
 // Marshal converts ThresholdSigner to byte array.
 func (ts *ThresholdSigner) Marshal() ([]byte, error) {
+	ts.shareMutex.RLock()
+	defer ts.shareMutex.RUnlock()
+
 	return (&pb.ThresholdSigner{
 		MemberIndex:          uint32(ts.memberIndex),
 		GroupPublicKey:       ts.groupPublicKey.Marshal(),
 		GroupPrivateKeyShare: ts.groupPrivateKeyShare.String(),
 		GroupPublicKeyShares: marshalGroupPublicKeyShares(ts.groupPublicKeyShares),
+		HonestThreshold:      uint32(ts.honestThreshold),
 	}).Marshal()
 }
 
@@ -61,6 +65,7 @@ func (ts *ThresholdSigner) Unmarshal(bytes []byte) error {
 	ts.groupPublicKey = groupPublicKey
 	ts.groupPrivateKeyShare = privateKeyShare
 	ts.groupPublicKeyShares = groupPublicKeyShares
+	ts.honestThreshold = int(pbThresholdSigner.HonestThreshold)
 
 	return nil
 }
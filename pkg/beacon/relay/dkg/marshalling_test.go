@@ -20,6 +20,7 @@ func TestThresholdSignerRoundtrip(t *testing.T) {
 			group.MemberIndex(1): new(bn256.G2).ScalarBaseMult(big.NewInt(10)),
 			group.MemberIndex(2): new(bn256.G2).ScalarBaseMult(big.NewInt(11)),
 		},
+		honestThreshold: 3,
 	}
 
 	unmarshaled := &ThresholdSigner{}
@@ -1,6 +1,7 @@
 package result
 
 import (
+	"context"
 	"fmt"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
@@ -50,7 +51,7 @@ func Publish(
 
 	stateMachine := state.NewMachine(channel, blockCounter, initialState)
 
-	lastState, _, err := stateMachine.Execute(startBlockHeight)
+	lastState, _, err := stateMachine.Execute(context.Background(), startBlockHeight)
 	if err != nil {
 		return err
 	}
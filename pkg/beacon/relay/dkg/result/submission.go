@@ -39,7 +39,9 @@ func NewSubmittingMember(
 //
 // If a result is submitted by another member and it's accepted by the chain,
 // the current member finishes the phase immediately, without submitting
-// their own result.
+// their own result. The same applies if the group public key is already
+// registered on-chain before or at the moment the member becomes eligible to
+// submit.
 //
 // It returns the on-chain block height of the moment when the result was
 // successfully submitted on chain by the member. In case of failure or result
@@ -93,6 +95,12 @@ func (sm *SubmittingMember) SubmitDKGResult(
 
 	// Someone who was ahead of us in the queue submitted the result. Giving up.
 	if alreadySubmitted {
+		logger.Infof(
+			"[member:%v] group public key [0x%x] already registered; "+
+				"skipping DKG result submission",
+			sm.index,
+			result.GroupPublicKey,
+		)
 		return returnWithError(nil)
 	}
 
@@ -112,12 +120,35 @@ func (sm *SubmittingMember) SubmitDKGResult(
 		select {
 		case blockNumber := <-eligibleToSubmitWaiter:
 			// Member becomes eligible to submit the result.
-			errorChannel := make(chan error)
-			defer close(errorChannel)
-
 			subscription.Unsubscribe()
 			close(onSubmittedResultChan)
 
+			// The submission event of another member might not have been
+			// delivered yet. Check the group registration once again so
+			// that the same group public key is not submitted twice.
+			alreadySubmitted, err := chainRelay.IsGroupRegistered(
+				result.GroupPublicKey,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"could not check if the result is already submitted: [%v]",
+					err,
+				)
+			}
+			if alreadySubmitted {
+				logger.Infof(
+					"[member:%v] group public key [0x%x] registered at block [%v]; "+
+						"skipping DKG result submission",
+					sm.index,
+					result.GroupPublicKey,
+					blockNumber,
+				)
+				return nil
+			}
+
+			errorChannel := make(chan error)
+			defer close(errorChannel)
+
 			logger.Infof(
 				"[member:%v] submitting DKG result with public key [0x%x] and "+
 					"[%v] supporting member signatures at block [%v]",
@@ -2,12 +2,14 @@ package result
 
 import (
 	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/chain/local"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 )
 
@@ -224,6 +226,77 @@ func TestConcurrentPublishResult(t *testing.T) {
 	}
 }
 
+func TestSubmitDKGResultAlreadyRegistered(t *testing.T) {
+	honestThreshold := 3
+	groupSize := 5
+
+	chainHandle, initialBlock, err := initChainHandle(honestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainRelay := chainHandle.ThresholdRelay()
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := &relayChain.DKGResult{
+		GroupPublicKey: []byte{123, 45},
+	}
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	// Register the group public key before the member attempts to submit.
+	registered := make(chan error)
+	chainRelay.SubmitDKGResult(1, result, signatures).OnComplete(
+		func(_ *event.DKGResultSubmission, err error) {
+			registered <- err
+		},
+	)
+	if err := <-registered; err != nil {
+		t.Fatal(err)
+	}
+
+	var submissionsMutex sync.Mutex
+	submissions := 0
+	subscription := chainRelay.OnDKGResultSubmitted(
+		func(event *event.DKGResultSubmission) {
+			submissionsMutex.Lock()
+			submissions++
+			submissionsMutex.Unlock()
+		},
+	)
+	defer subscription.Unsubscribe()
+
+	member := &SubmittingMember{
+		index: group.MemberIndex(2),
+	}
+	err = member.SubmitDKGResult(
+		result,
+		signatures,
+		chainRelay,
+		blockCounter,
+		initialBlock,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	submissionsMutex.Lock()
+	defer submissionsMutex.Unlock()
+	if submissions != 0 {
+		t.Errorf(
+			"unexpected number of duplicate submissions\nexpected: %v\nactual:   %v\n",
+			0,
+			submissions,
+		)
+	}
+}
+
 func initChainHandle(honestThreshold int, groupSize int) (chain.Handle, uint64, error) {
 	chainHandle := local.Connect(groupSize, honestThreshold, big.NewInt(200))
 
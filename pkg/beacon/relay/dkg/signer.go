@@ -1,12 +1,15 @@
 package dkg
 
 import (
+	"fmt"
 	"math/big"
+	"sync"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/altbn128"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/bls"
+	"github.com/keep-network/keep-core/pkg/internal/wipe"
 )
 
 // ThresholdSigner is created from GJKR group Member when DKG protocol completed
@@ -19,6 +22,13 @@ type ThresholdSigner struct {
 	groupPublicKey       *bn256.G2
 	groupPrivateKeyShare *big.Int
 	groupPublicKeyShares map[group.MemberIndex]*bn256.G2
+	// Number of signature shares needed to complete a group signature, equal
+	// to the degree of the group polynomial plus one. Zero if not known.
+	honestThreshold int
+
+	// Guards the share of the group private key so that it is not wiped
+	// while being used.
+	shareMutex sync.RWMutex
 }
 
 // NewThresholdSigner returns a new ThresholdSigner
@@ -41,6 +51,13 @@ func (ts *ThresholdSigner) MemberID() group.MemberIndex {
 	return ts.memberIndex
 }
 
+// HonestThreshold returns the number of signature shares needed to complete
+// a group signature, as determined by the degree of polynomials used in DKG.
+// It returns zero if the signer has not been created by ExecuteDKG.
+func (ts *ThresholdSigner) HonestThreshold() int {
+	return ts.honestThreshold
+}
+
 // GroupPublicKeyBytes returns group public key bytes in an uncompressed form.
 func (ts *ThresholdSigner) GroupPublicKeyBytes() []byte {
 	return ts.groupPublicKey.Marshal()
@@ -54,9 +71,22 @@ func (ts *ThresholdSigner) GroupPublicKeyBytesCompressed() []byte {
 }
 
 // CalculateSignatureShare takes the message and calculates signer's signature
-// share over that message.
-func (ts *ThresholdSigner) CalculateSignatureShare(message *bn256.G1) *bn256.G1 {
-	return bls.SignG1(ts.groupPrivateKeyShare, message)
+// share over that message. An error is returned if the share of the group
+// private key has been wiped.
+func (ts *ThresholdSigner) CalculateSignatureShare(
+	message *bn256.G1,
+) (*bn256.G1, error) {
+	ts.shareMutex.RLock()
+	defer ts.shareMutex.RUnlock()
+
+	if ts.groupPrivateKeyShare.Sign() == 0 {
+		return nil, fmt.Errorf(
+			"share of the group private key of member [%v] has been wiped",
+			ts.memberIndex,
+		)
+	}
+
+	return bls.SignG1(ts.groupPrivateKeyShare, message), nil
 }
 
 // CompleteSignature accepts signature shares from all group threshold signers
@@ -71,6 +101,17 @@ func (ts *ThresholdSigner) CompleteSignature(
 	return bls.RecoverSignature(signatureShares, honestThreshold)
 }
 
+// Wipe overwrites the signer's share of the group private key with zeros.
+// It waits for signature shares being calculated with the share to complete.
+// Once the share has been wiped, no more signature shares can be calculated
+// by the signer.
+func (ts *ThresholdSigner) Wipe() {
+	ts.shareMutex.Lock()
+	defer ts.shareMutex.Unlock()
+
+	wipe.Int(ts.groupPrivateKeyShare)
+}
+
 // GroupPublicKeyShares returns group public key shares for each
 // individual member of the group.
 func (ts *ThresholdSigner) GroupPublicKeyShares() map[group.MemberIndex]*bn256.G2 {
@@ -85,7 +85,10 @@ func TestSignAndComplete(t *testing.T) {
 		// Ensure we get a valid signature share from every signer.
 		shares := make([]*bls.SignatureShare, 0)
 		for _, signer := range signers {
-			share := signer.CalculateSignatureShare(message)
+			share, err := signer.CalculateSignatureShare(message)
+			if err != nil {
+				t.Fatal(err)
+			}
 
 			shares = append(shares,
 				&bls.SignatureShare{
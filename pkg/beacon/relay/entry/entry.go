@@ -28,18 +28,74 @@ func RegisterUnmarshallers(channel net.BroadcastChannel) {
 	})
 }
 
-// SignAndSubmit triggers the threshold signature process for the
-// previous relay entry and publishes the signature to the chain as
-// a new relay entry.
+// HashToSignInput converts the previous relay entry into the input signed by
+// the group to produce the new relay entry. The returned bytes have to be
+// a marshalled G1 point. The chain verifies new entries as signatures of the
+// previous entry itself, so only DefaultHashToSignInput produces entries the
+// chain and VerifyEntryAgainstGroups accept; other functions are meant for
+// experiments on local chains.
+type HashToSignInput func(previousEntry []byte) []byte
+
+// DefaultHashToSignInput is the HashToSignInput used unless configured
+// otherwise. The previous relay entry is a G1 point itself and it is signed
+// as is, which is what the chain expects when verifying the new entry.
+func DefaultHashToSignInput(previousEntry []byte) []byte {
+	return previousEntry
+}
+
+// SignAndSubmit triggers the threshold signature process for the sign input
+// derived from the previous relay entry and publishes the signature to the
+// chain as a new relay entry. The previous relay entry itself is used to tell
+// whether the chain still waits for the entry.
+//
+// Only activeMembers, as returned by ActiveGroupMembers, take part in the
+// signing. An error is returned if the signer is not active or if there are
+// fewer active members than the honest threshold. The honest threshold is
+// raised to the signer's one if the group polynomial has a higher degree.
+//
+// Valid signature shares are collected until there are enough of them or
+// until the relay entry timeout block. The signature is verified against the
+// public key of the selected group and never submitted if it does not match.
+//
+// If the signer submits the entry, the optional onConfirmed callback is called
+// once the entry is buried under the relay entry confirmation depth. It may be
+// called after SignAndSubmit returns. Errors can be classified with
+// ClassifyFailure.
 func SignAndSubmit(
 	blockCounter chain.BlockCounter,
 	channel net.BroadcastChannel,
 	relayChain relayChain.Interface,
+	groupPublicKeyBytes []byte,
 	previousEntryBytes []byte,
+	signInputBytes []byte,
 	honestThreshold int,
 	signer *dkg.ThresholdSigner,
+	activeMembers map[group.MemberIndex]bool,
 	startBlockHeight uint64,
+	onConfirmed func(newEntry []byte),
 ) error {
+	if signer.HonestThreshold() > honestThreshold {
+		// The group polynomial has a higher degree than the chain
+		// configuration implies, so more shares are needed for a valid
+		// signature.
+		honestThreshold = signer.HonestThreshold()
+	}
+
+	if !activeMembers[signer.MemberID()] {
+		return fmt.Errorf(
+			"member [%v] is not active on-chain",
+			signer.MemberID(),
+		)
+	}
+	if len(activeMembers) < honestThreshold {
+		return fmt.Errorf(
+			"%w: [%v] active group members is below the honest threshold [%v]",
+			ErrInsufficientShares,
+			len(activeMembers),
+			honestThreshold,
+		)
+	}
+
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
 
@@ -60,13 +116,22 @@ func SignAndSubmit(
 		return err
 	}
 
-	previousEntry := new(bn256.G1)
-	_, err = previousEntry.Unmarshal(previousEntryBytes)
+	signInput := new(bn256.G1)
+	_, err = signInput.Unmarshal(signInputBytes)
 	if err != nil {
 		return err
 	}
 
-	selfShare := signer.CalculateSignatureShare(previousEntry)
+	groupPublicKey := new(bn256.G2)
+	_, err = groupPublicKey.Unmarshal(groupPublicKeyBytes)
+	if err != nil {
+		return fmt.Errorf("could not unmarshal group public key: [%v]", err)
+	}
+
+	selfShare, err := signer.CalculateSignatureShare(signInput)
+	if err != nil {
+		return fmt.Errorf("could not calculate signature share: [%v]", err)
+	}
 
 	go broadcastShare(ctx, signer.MemberID(), selfShare, channel)
 
@@ -91,10 +156,20 @@ func SignAndSubmit(
 				continue
 			}
 
+			if !activeMembers[message.senderID] {
+				logger.Warningf(
+					"[member:%v] rejecting signature share from "+
+						"member [%v]; member is not active on-chain",
+					signer.MemberID(),
+					message.senderID,
+				)
+				continue
+			}
+
 			share, err := extractAndValidateShare(
 				message,
 				signer.GroupPublicKeyShares(),
-				previousEntry,
+				signInput,
 			)
 			if err != nil {
 				logger.Warningf(
@@ -124,7 +199,8 @@ func SignAndSubmit(
 			return nil
 		case blockNumber := <-relayEntryTimeoutChannel:
 			return fmt.Errorf(
-				"relay entry timed out at block [%v]; received [%v] valid signature shares",
+				"%w: timed out at block [%v]; received [%v] valid signature shares",
+				ErrRelayEntryTimeout,
 				blockNumber,
 				len(receivedValidShares),
 			)
@@ -133,13 +209,25 @@ func SignAndSubmit(
 
 	signature, err := completeSignature(signer, receivedValidShares, honestThreshold)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: [%v]", ErrInsufficientShares, err)
+	}
+
+	if !bls.VerifyG1(groupPublicKey, signInput, signature) {
+		return fmt.Errorf(
+			"%w: signature recovered from [%v] shares does not match "+
+				"the group public key [0x%x]",
+			ErrVerificationFailed,
+			len(receivedValidShares),
+			groupPublicKeyBytes,
+		)
 	}
 
 	submitter := &relayEntrySubmitter{
-		chain:        relayChain,
-		blockCounter: blockCounter,
-		index:        signer.MemberID(),
+		chain:         relayChain,
+		blockCounter:  blockCounter,
+		index:         signer.MemberID(),
+		previousEntry: previousEntryBytes,
+		onConfirmed:   onConfirmed,
 	}
 
 	// relayEntrySubmittedChannel and relayEntryTimeoutChannel are passed to
@@ -156,6 +244,47 @@ func SignAndSubmit(
 	)
 }
 
+// ActiveGroupMembers returns the set of signer's group members which are
+// still active on-chain. Only the signer itself and members the signer has
+// a group public key share for, that is members which completed the key
+// generation, are considered. All members of the group share the same set,
+// so it is meant to be looked up once per relay request and group.
+//
+// If the chain could not tell whether a member is active, an error is
+// returned so that no signing starts with members whose stake is unknown.
+func ActiveGroupMembers(
+	relayChain relayChain.Interface,
+	signer *dkg.ThresholdSigner,
+) (map[group.MemberIndex]bool, error) {
+	memberIDs := []group.MemberIndex{signer.MemberID()}
+	for memberID := range signer.GroupPublicKeyShares() {
+		if memberID != signer.MemberID() {
+			memberIDs = append(memberIDs, memberID)
+		}
+	}
+
+	activeMembers := make(map[group.MemberIndex]bool)
+	for _, memberID := range memberIDs {
+		isActive, err := relayChain.IsGroupMemberActive(
+			signer.GroupPublicKeyBytes(),
+			memberID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not check if member [%v] is active: [%v]",
+				memberID,
+				err,
+			)
+		}
+
+		if isActive {
+			activeMembers[memberID] = true
+		}
+	}
+
+	return activeMembers, nil
+}
+
 func broadcastShare(
 	ctx context.Context,
 	memberID group.MemberIndex,
@@ -179,7 +308,7 @@ func broadcastShare(
 func extractAndValidateShare(
 	message *SignatureShareMessage,
 	groupPublicKeyShares map[group.MemberIndex]*bn256.G2,
-	previousEntry *bn256.G1,
+	signInput *bn256.G1,
 ) (*bn256.G1, error) {
 	share := new(bn256.G1)
 	_, err := share.Unmarshal(message.shareBytes)
@@ -198,7 +327,7 @@ func extractAndValidateShare(
 		)
 	}
 
-	if !bls.VerifyG1(publicKeyShare, previousEntry, share) {
+	if !bls.VerifyG1(publicKeyShare, signInput, share) {
 		return nil, fmt.Errorf("invalid signature share")
 	}
 
@@ -0,0 +1,79 @@
+package entry
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestActiveGroupMembers(t *testing.T) {
+	groupPublicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+	for memberID := group.MemberIndex(1); memberID <= 4; memberID++ {
+		groupPublicKeyShares[memberID] = new(bn256.G2).ScalarBaseMult(
+			big.NewInt(int64(memberID)),
+		)
+	}
+
+	signer := dkg.NewThresholdSigner(
+		group.MemberIndex(1),
+		new(bn256.G2).ScalarBaseMult(big.NewInt(10)),
+		big.NewInt(1),
+		groupPublicKeyShares,
+	)
+
+	chain := &memberActivityChain{
+		inactiveMembers: map[group.MemberIndex]bool{2: true},
+	}
+
+	activeMembers, err := ActiveGroupMembers(chain, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedActiveMembers := map[group.MemberIndex]bool{1: true, 3: true, 4: true}
+	if !reflect.DeepEqual(expectedActiveMembers, activeMembers) {
+		t.Errorf(
+			"unexpected active members\nexpected: %v\nactual:   %v\n",
+			expectedActiveMembers,
+			activeMembers,
+		)
+	}
+
+	// Member 3 can not be checked, so no members are considered active.
+	chain.failingMembers = map[group.MemberIndex]bool{3: true}
+
+	activeMembers, err = ActiveGroupMembers(chain, signer)
+	if err == nil {
+		t.Fatal("expected an error when the member activity lookup fails")
+	}
+	if activeMembers != nil {
+		t.Errorf(
+			"unexpected active members\nexpected: %v\nactual:   %v\n",
+			nil,
+			activeMembers,
+		)
+	}
+}
+
+type memberActivityChain struct {
+	relayChain.Interface
+
+	inactiveMembers map[group.MemberIndex]bool
+	failingMembers  map[group.MemberIndex]bool
+}
+
+func (mac *memberActivityChain) IsGroupMemberActive(
+	groupPublicKey []byte,
+	memberIndex relayChain.GroupMemberIndex,
+) (bool, error) {
+	if mac.failingMembers[memberIndex] {
+		return false, fmt.Errorf("could not reach the chain")
+	}
+	return !mac.inactiveMembers[memberIndex], nil
+}
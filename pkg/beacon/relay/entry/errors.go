@@ -0,0 +1,45 @@
+package entry
+
+import "errors"
+
+// Errors returned by SignAndSubmit are wrapping one of the following errors
+// so that callers can use errors.Is to tell what kind of failure occurred.
+var (
+	// ErrRelayEntryTimeout is returned when the relay entry timeout block
+	// has been reached before the entry has been signed and submitted.
+	ErrRelayEntryTimeout = errors.New("relay entry timeout")
+
+	// ErrInsufficientShares is returned when there is not enough active
+	// members or valid signature shares to produce the signature.
+	ErrInsufficientShares = errors.New("insufficient signature shares")
+
+	// ErrVerificationFailed is returned when the signature recovered from
+	// signature shares does not verify against the group public key.
+	ErrVerificationFailed = errors.New("signature verification failed")
+)
+
+// Failure is a category of a relay entry signing failure.
+type Failure string
+
+// Categories of relay entry signing failures.
+const (
+	FailureTimeout            Failure = "timeout"
+	FailureInsufficientShares Failure = "insufficient_shares"
+	FailureVerificationFailed Failure = "verification_failed"
+	FailureOther              Failure = "other"
+)
+
+// ClassifyFailure returns the category of the given error returned by
+// SignAndSubmit.
+func ClassifyFailure(err error) Failure {
+	switch {
+	case errors.Is(err, ErrRelayEntryTimeout):
+		return FailureTimeout
+	case errors.Is(err, ErrInsufficientShares):
+		return FailureInsufficientShares
+	case errors.Is(err, ErrVerificationFailed):
+		return FailureVerificationFailed
+	default:
+		return FailureOther
+	}
+}
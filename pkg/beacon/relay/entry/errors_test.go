@@ -0,0 +1,43 @@
+package entry
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestClassifyFailure(t *testing.T) {
+	var tests = map[string]struct {
+		err             error
+		expectedFailure Failure
+	}{
+		"relay entry timeout": {
+			err:             fmt.Errorf("%w: timed out at block [10]", ErrRelayEntryTimeout),
+			expectedFailure: FailureTimeout,
+		},
+		"insufficient shares": {
+			err:             fmt.Errorf("%w: [2] active group members", ErrInsufficientShares),
+			expectedFailure: FailureInsufficientShares,
+		},
+		"verification failed": {
+			err:             fmt.Errorf("%w: invalid signature", ErrVerificationFailed),
+			expectedFailure: FailureVerificationFailed,
+		},
+		"unknown error": {
+			err:             fmt.Errorf("member [1] is not active on-chain"),
+			expectedFailure: FailureOther,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			failure := ClassifyFailure(test.err)
+			if failure != test.expectedFailure {
+				t.Errorf(
+					"unexpected failure\nexpected: %v\nactual:   %v\n",
+					test.expectedFailure,
+					failure,
+				)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package entry
 
 import (
+	"bytes"
 	"fmt"
+	"sync"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
@@ -14,6 +16,13 @@ type relayEntrySubmitter struct {
 	blockCounter chain.BlockCounter
 
 	index group.MemberIndex
+
+	previousEntry []byte
+
+	onConfirmed func(newEntry []byte)
+
+	// Confirmations of submitted entries still in progress.
+	confirmations sync.WaitGroup
 }
 
 // submitRelayEntry submits the provided relay entry data to the chain.
@@ -21,7 +30,10 @@ type relayEntrySubmitter struct {
 // Group member with index 1 tries to submit as the first one, group member 2
 // tries to submit after a few blocks if member 1 did not submit and so on.
 // Relay entry submit process starts at block height defined by startBlockheight
-// parameter.
+// parameter. If the entry is submitted by this member, the onConfirmed callback
+// of the submitter is called once the entry is confirmed. Waiting for the
+// confirmation takes many blocks, so it is done in the background after this
+// function returns.
 func (res *relayEntrySubmitter) submitRelayEntry(
 	newEntry []byte,
 	groupPublicKey []byte,
@@ -47,9 +59,6 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 		select {
 		case blockNumber := <-eligibleToSubmitWaiter:
 			// Member becomes eligible to submit the result.
-			errorChannel := make(chan error)
-			defer close(errorChannel)
-
 			logger.Infof(
 				"[member:%v] submitting relay entry [0x%x] on behalf of group "+
 					"[0x%x] at block [%v]",
@@ -59,20 +68,7 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 				blockNumber,
 			)
 
-			res.chain.SubmitRelayEntry(newEntry).OnComplete(
-				func(entry *event.EntrySubmitted, err error) {
-					if err == nil {
-						logger.Infof(
-							"[member:%v] successfully submitted "+
-								"relay entry at block: [%v]",
-							res.index,
-							entry.BlockNumber,
-						)
-					}
-					errorChannel <- err
-				})
-
-			entryErr := <-errorChannel
+			submittedEntry, entryErr := res.submit(newEntry)
 
 			if entryErr != nil {
 				isEntryInProgress, err := res.chain.IsEntryInProgress()
@@ -97,9 +93,33 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 					)
 					return nil
 				}
+
+				return entryErr
+			}
+
+			if config.RelayEntryConfirmationDepth > 0 {
+				res.confirmations.Add(1)
+				go func() {
+					defer res.confirmations.Done()
+
+					err := res.confirmRelayEntry(
+						newEntry,
+						submittedEntry.BlockNumber,
+						config.RelayEntryConfirmationDepth,
+					)
+					if err != nil {
+						logger.Errorf(
+							"[member:%v] could not confirm relay entry: [%v]",
+							res.index,
+							err,
+						)
+					}
+				}()
+				return nil
 			}
 
-			return entryErr
+			res.notifyConfirmed(newEntry)
+			return nil
 		case blockNumber := <-relayEntrySubmittedChannel:
 			logger.Infof(
 				"[member:%v] leaving submitter; "+
@@ -110,7 +130,8 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 			return nil
 		case blockNumber := <-relayEntryTimeoutChannel:
 			return fmt.Errorf(
-				"relay entry timed out at block [%v]",
+				"%w: timed out at block [%v]",
+				ErrRelayEntryTimeout,
 				blockNumber,
 			)
 		}
@@ -141,3 +162,117 @@ func (res *relayEntrySubmitter) waitForSubmissionEligibility(
 
 	return waiter, err
 }
+
+// submit submits the provided relay entry to the chain and waits for the
+// submission to complete.
+func (res *relayEntrySubmitter) submit(
+	newEntry []byte,
+) (*event.EntrySubmitted, error) {
+	type submissionResult struct {
+		entry *event.EntrySubmitted
+		err   error
+	}
+
+	resultChannel := make(chan submissionResult, 1)
+
+	res.chain.SubmitRelayEntry(newEntry).OnComplete(
+		func(entry *event.EntrySubmitted, err error) {
+			if err == nil {
+				logger.Infof(
+					"[member:%v] successfully submitted "+
+						"relay entry at block: [%v]",
+					res.index,
+					entry.BlockNumber,
+				)
+			}
+			resultChannel <- submissionResult{entry, err}
+		})
+
+	result := <-resultChannel
+	return result.entry, result.err
+}
+
+// confirmRelayEntry waits until the block the relay entry was submitted in
+// is buried under the provided number of blocks and checks if the entry is
+// still a part of the canonical chain. If the entry has been dropped by
+// a chain reorganization, it is submitted again and the confirmation starts
+// over for the new submission. The entry is resubmitted at most once.
+func (res *relayEntrySubmitter) confirmRelayEntry(
+	newEntry []byte,
+	submissionBlock uint64,
+	confirmationDepth uint64,
+) error {
+	for resubmitted := false; ; resubmitted = true {
+		err := res.blockCounter.WaitForBlockHeight(
+			submissionBlock + confirmationDepth,
+		)
+		if err != nil {
+			return fmt.Errorf("block height waiter failure: [%v]", err)
+		}
+
+		isDropped, err := res.isRelayEntryDropped()
+		if err != nil {
+			return fmt.Errorf(
+				"could not confirm relay entry submitted at block [%v]: [%v]",
+				submissionBlock,
+				err,
+			)
+		}
+
+		if !isDropped {
+			res.notifyConfirmed(newEntry)
+			return nil
+		}
+
+		if resubmitted {
+			logger.Warningf(
+				"[member:%v] resubmitted relay entry at block [%v] is no "+
+					"longer on the canonical chain; giving up",
+				res.index,
+				submissionBlock,
+			)
+			return nil
+		}
+
+		logger.Warningf(
+			"[member:%v] relay entry submitted at block [%v] is no longer "+
+				"on the canonical chain; resubmitting",
+			res.index,
+			submissionBlock,
+		)
+
+		resubmittedEntry, err := res.submit(newEntry)
+		if err != nil {
+			return err
+		}
+		submissionBlock = resubmittedEntry.BlockNumber
+	}
+}
+
+func (res *relayEntrySubmitter) notifyConfirmed(newEntry []byte) {
+	if res.onConfirmed != nil {
+		res.onConfirmed(newEntry)
+	}
+}
+
+// isRelayEntryDropped checks if the chain is still waiting for an entry for
+// the request the submitter signed. It is the case when a relay entry is in
+// progress and the previous entry of the current request is the same one the
+// submitter produced the new entry from.
+func (res *relayEntrySubmitter) isRelayEntryDropped() (bool, error) {
+	isEntryInProgress, err := res.chain.IsEntryInProgress()
+	if err != nil {
+		return false, err
+	}
+
+	if !isEntryInProgress {
+		return false, nil
+	}
+
+	currentPreviousEntry, err := res.chain.CurrentRequestPreviousEntry()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(currentPreviousEntry, res.previousEntry), nil
+}
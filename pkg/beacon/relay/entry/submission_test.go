@@ -0,0 +1,266 @@
+package entry
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+)
+
+func TestSubmitRelayEntryConfirmation(t *testing.T) {
+	previousEntry := []byte{1, 2, 3}
+	newEntry := []byte{4, 5, 6}
+
+	var tests = map[string]struct {
+		confirmationDepth         uint64
+		droppedByReorg            bool
+		currentPreviousEntry      []byte
+		expectedSubmissions       int
+		expectedConfirmationBlock uint64
+	}{
+		"entry dropped by reorg is resubmitted": {
+			confirmationDepth:         6,
+			droppedByReorg:            true,
+			currentPreviousEntry:      previousEntry,
+			expectedSubmissions:       2,
+			expectedConfirmationBlock: 106,
+		},
+		"entry on canonical chain is not resubmitted": {
+			confirmationDepth:         6,
+			droppedByReorg:            false,
+			expectedSubmissions:       1,
+			expectedConfirmationBlock: 106,
+		},
+		"entry followed by a new request is not resubmitted": {
+			confirmationDepth:         6,
+			droppedByReorg:            true,
+			currentPreviousEntry:      newEntry,
+			expectedSubmissions:       1,
+			expectedConfirmationBlock: 106,
+		},
+		"confirmation disabled": {
+			confirmationDepth:   0,
+			droppedByReorg:      true,
+			expectedSubmissions: 1,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chain := &reorgChain{
+				config: &relayChain.Config{
+					ResultPublicationBlockStep:  3,
+					RelayEntryConfirmationDepth: test.confirmationDepth,
+				},
+				submissionBlock:      100,
+				droppedByReorg:       test.droppedByReorg,
+				currentPreviousEntry: test.currentPreviousEntry,
+			}
+			blockCounter := &instantBlockCounter{}
+
+			submitter := &relayEntrySubmitter{
+				chain:         chain,
+				blockCounter:  blockCounter,
+				index:         1,
+				previousEntry: previousEntry,
+			}
+
+			err := submitter.submitRelayEntry(
+				newEntry,
+				[]byte{10},
+				0,
+				make(chan uint64),
+				make(chan uint64),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			submitter.confirmations.Wait()
+
+			if chain.submissions != test.expectedSubmissions {
+				t.Errorf(
+					"unexpected number of submissions\nexpected: %v\nactual:   %v",
+					test.expectedSubmissions,
+					chain.submissions,
+				)
+			}
+
+			if blockCounter.lastWaitedBlock != test.expectedConfirmationBlock {
+				t.Errorf(
+					"unexpected confirmation block\nexpected: %v\nactual:   %v",
+					test.expectedConfirmationBlock,
+					blockCounter.lastWaitedBlock,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmitRelayEntryConfirmedCallback(t *testing.T) {
+	previousEntry := []byte{1, 2, 3}
+	newEntry := []byte{4, 5, 6}
+
+	var tests = map[string]struct {
+		confirmationDepth     uint64
+		droppedByReorg        bool
+		expectedCallbackBlock uint64
+	}{
+		"callback after confirmation depth blocks": {
+			confirmationDepth:     6,
+			expectedCallbackBlock: 106,
+		},
+		"callback after resubmitted entry confirmation": {
+			confirmationDepth:     12,
+			droppedByReorg:        true,
+			expectedCallbackBlock: 112,
+		},
+		"callback right after submission if confirmation disabled": {
+			confirmationDepth:     0,
+			expectedCallbackBlock: 0,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chain := &reorgChain{
+				config: &relayChain.Config{
+					ResultPublicationBlockStep:  3,
+					RelayEntryConfirmationDepth: test.confirmationDepth,
+				},
+				submissionBlock:      100,
+				droppedByReorg:       test.droppedByReorg,
+				currentPreviousEntry: previousEntry,
+			}
+			blockCounter := &instantBlockCounter{}
+
+			var confirmedEntries [][]byte
+			var callbackBlock uint64
+			onConfirmed := func(entry []byte) {
+				confirmedEntries = append(confirmedEntries, entry)
+				callbackBlock, _ = blockCounter.CurrentBlock()
+			}
+
+			submitter := &relayEntrySubmitter{
+				chain:         chain,
+				blockCounter:  blockCounter,
+				index:         1,
+				previousEntry: previousEntry,
+				onConfirmed:   onConfirmed,
+			}
+
+			err := submitter.submitRelayEntry(
+				newEntry,
+				[]byte{10},
+				0,
+				make(chan uint64),
+				make(chan uint64),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			submitter.confirmations.Wait()
+
+			if len(confirmedEntries) != 1 {
+				t.Fatalf(
+					"unexpected number of confirmed entries\n"+
+						"expected: %v\nactual:   %v",
+					1,
+					len(confirmedEntries),
+				)
+			}
+
+			if !bytes.Equal(confirmedEntries[0], newEntry) {
+				t.Errorf(
+					"unexpected confirmed entry\nexpected: %v\nactual:   %v",
+					newEntry,
+					confirmedEntries[0],
+				)
+			}
+
+			if callbackBlock != test.expectedCallbackBlock {
+				t.Errorf(
+					"unexpected callback block\nexpected: %v\nactual:   %v",
+					test.expectedCallbackBlock,
+					callbackBlock,
+				)
+			}
+		})
+	}
+}
+
+// reorgChain is a relay chain stub which drops the first submitted relay entry
+// from the canonical chain if droppedByReorg is set.
+type reorgChain struct {
+	relayChain.Interface
+
+	mutex sync.Mutex
+
+	config               *relayChain.Config
+	submissionBlock      uint64
+	droppedByReorg       bool
+	currentPreviousEntry []byte
+
+	submissions int
+}
+
+func (rc *reorgChain) GetConfig() *relayChain.Config {
+	return rc.config
+}
+
+func (rc *reorgChain) SubmitRelayEntry(
+	entry []byte,
+) *async.EventEntrySubmittedPromise {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	rc.submissions++
+
+	promise := &async.EventEntrySubmittedPromise{}
+	go promise.Fulfill(&event.EntrySubmitted{
+		BlockNumber: rc.submissionBlock,
+	})
+
+	return promise
+}
+
+func (rc *reorgChain) IsEntryInProgress() (bool, error) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	// The entry is in progress again only when the first submission was
+	// dropped by a reorg.
+	return rc.droppedByReorg && rc.submissions == 1, nil
+}
+
+func (rc *reorgChain) CurrentRequestPreviousEntry() ([]byte, error) {
+	return rc.currentPreviousEntry, nil
+}
+
+type instantBlockCounter struct {
+	lastWaitedBlock uint64
+}
+
+func (ibc *instantBlockCounter) WaitForBlockHeight(blockNumber uint64) error {
+	ibc.lastWaitedBlock = blockNumber
+	return nil
+}
+
+func (ibc *instantBlockCounter) BlockHeightWaiter(
+	blockNumber uint64,
+) (<-chan uint64, error) {
+	waiter := make(chan uint64, 1)
+	waiter <- blockNumber
+	return waiter, nil
+}
+
+func (ibc *instantBlockCounter) CurrentBlock() (uint64, error) {
+	return ibc.lastWaitedBlock, nil
+}
+
+func (ibc *instantBlockCounter) WatchBlocks(ctx context.Context) <-chan uint64 {
+	return make(chan uint64)
+}
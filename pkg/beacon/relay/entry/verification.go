@@ -0,0 +1,55 @@
+package entry
+
+import (
+	"fmt"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/bls"
+)
+
+// VerifyEntryAgainstGroups checks which of the groups with the given public
+// keys produced the given relay entry. It returns the index of the first
+// group public key the entry verifies against as a signature of the previous
+// entry, that is the input signed with DefaultHashToSignInput. If no group
+// public key verifies the entry, an error wrapping ErrVerificationFailed is
+// returned. Group public keys which can not be unmarshalled are skipped.
+func VerifyEntryAgainstGroups(
+	entry event.Entry,
+	groupPublicKeys [][]byte,
+) (int, error) {
+	signature := new(bn256.G1)
+	if _, err := signature.Unmarshal(entry.Value); err != nil {
+		return -1, fmt.Errorf("could not unmarshal relay entry: [%v]", err)
+	}
+
+	previousEntry := new(bn256.G1)
+	if _, err := previousEntry.Unmarshal(entry.PreviousEntry); err != nil {
+		return -1, fmt.Errorf(
+			"could not unmarshal previous relay entry: [%v]",
+			err,
+		)
+	}
+
+	for index, groupPublicKeyBytes := range groupPublicKeys {
+		groupPublicKey := new(bn256.G2)
+		if _, err := groupPublicKey.Unmarshal(groupPublicKeyBytes); err != nil {
+			logger.Warningf(
+				"could not unmarshal group public key [0x%x]: [%v]",
+				groupPublicKeyBytes,
+				err,
+			)
+			continue
+		}
+
+		if bls.VerifyG1(groupPublicKey, previousEntry, signature) {
+			return index, nil
+		}
+	}
+
+	return -1, fmt.Errorf(
+		"%w: relay entry does not verify against any of [%v] groups",
+		ErrVerificationFailed,
+		len(groupPublicKeys),
+	)
+}
@@ -0,0 +1,72 @@
+package entry
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+)
+
+func TestVerifyEntryAgainstGroups(t *testing.T) {
+	previousEntry := new(bn256.G1).ScalarBaseMult(big.NewInt(1337))
+
+	groupPrivateKeys := []*big.Int{
+		big.NewInt(101),
+		big.NewInt(102),
+		big.NewInt(103),
+	}
+	groupPublicKeys := make([][]byte, len(groupPrivateKeys))
+	for i, privateKey := range groupPrivateKeys {
+		groupPublicKeys[i] = new(bn256.G2).ScalarBaseMult(privateKey).Marshal()
+	}
+
+	entry := event.Entry{
+		Value: new(bn256.G1).ScalarMult(
+			previousEntry,
+			groupPrivateKeys[1],
+		).Marshal(),
+		PreviousEntry: previousEntry.Marshal(),
+	}
+
+	index, err := VerifyEntryAgainstGroups(entry, groupPublicKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedIndex := 1
+	if index != expectedIndex {
+		t.Errorf(
+			"unexpected group index\nexpected: %v\nactual:   %v",
+			expectedIndex,
+			index,
+		)
+	}
+}
+
+func TestVerifyEntryAgainstGroupsNoMatchingGroup(t *testing.T) {
+	previousEntry := new(bn256.G1).ScalarBaseMult(big.NewInt(1337))
+
+	groupPublicKeys := [][]byte{
+		new(bn256.G2).ScalarBaseMult(big.NewInt(101)).Marshal(),
+		new(bn256.G2).ScalarBaseMult(big.NewInt(102)).Marshal(),
+	}
+
+	entry := event.Entry{
+		Value: new(bn256.G1).ScalarMult(
+			previousEntry,
+			big.NewInt(999),
+		).Marshal(),
+		PreviousEntry: previousEntry.Marshal(),
+	}
+
+	_, err := VerifyEntryAgainstGroups(entry, groupPublicKeys)
+	if !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrVerificationFailed,
+			err,
+		)
+	}
+}
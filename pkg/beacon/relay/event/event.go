@@ -21,6 +21,13 @@ type EntryGenerated struct {
 	BlockNumber uint64
 }
 
+// Entry represents a relay entry along with the previous relay entry it is
+// a signature of. Both values are marshalled G1 points.
+type Entry struct {
+	Value         []byte
+	PreviousEntry []byte
+}
+
 // Request represents a request for an entry in the threshold relay.
 type Request struct {
 	PreviousEntry  []byte
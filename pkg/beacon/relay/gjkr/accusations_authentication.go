@@ -0,0 +1,153 @@
+package gjkr
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+)
+
+// Accusations published in phases 4 and 8 may get members disqualified and,
+// as a result, slashed. To make them non-repudiable, the accuser signs them
+// with its operator key. The signed bytes cover the seed of the key
+// generation, the message type and the sender, so that signed accusations
+// can not be replayed in another key generation, in the other accusations
+// phase or on behalf of another member.
+//
+// Members created without a signer, like the ones executing the self-test,
+// neither sign published accusations nor verify received ones.
+
+// accusationsSignableBytes returns bytes signed by the accuser.
+func accusationsSignableBytes(
+	messageType string,
+	seed *big.Int,
+	senderID group.MemberIndex,
+	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+) []byte {
+	return messageSignableBytes(
+		messageType,
+		seed,
+		senderID,
+		accusationsContent(accusedMembersKeys),
+	)
+}
+
+// accusationsContent returns accused member indexes and private ephemeral
+// keys revealed for them. Accusations are serialized in the order of accused
+// member indexes so that the bytes do not depend on the map iteration order.
+func accusationsContent(
+	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+) []byte {
+	var content []byte
+	for _, accusedID := range accusedMemberIDs(accusedMembersKeys) {
+		content = append(content, byte(accusedID))
+		if privateKey := accusedMembersKeys[accusedID]; privateKey != nil {
+			content = append(content, privateKey.Marshal()...)
+		}
+	}
+
+	return content
+}
+
+// authenticateAccusations signs accusations of the member. It returns nil if
+// the member has no signer.
+func (mc *memberCore) authenticateAccusations(
+	messageType string,
+	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+) (*messageAuthentication, error) {
+	return mc.authenticateMessage(
+		messageType,
+		accusationsContent(accusedMembersKeys),
+	)
+}
+
+// verifyAccusationsAuthentication checks that accusations have been signed by
+// the operator of the member who sent them.
+func (mc *memberCore) verifyAccusationsAuthentication(
+	messageType string,
+	senderID group.MemberIndex,
+	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+	authentication *messageAuthentication,
+) error {
+	if authentication == nil {
+		return fmt.Errorf("accusations are not signed")
+	}
+
+	return mc.verifyMessageAuthentication(
+		messageType,
+		senderID,
+		accusationsContent(accusedMembersKeys),
+		authentication,
+	)
+}
+
+// authenticSecretSharesAccusationsMessages returns messages with accusations
+// properly signed by their senders, rejecting unsigned and forged ones. All
+// messages are returned if the member has no signer.
+func (mc *memberCore) authenticSecretSharesAccusationsMessages(
+	messages []*SecretSharesAccusationsMessage,
+) []*SecretSharesAccusationsMessage {
+	if mc.signing == nil {
+		return messages
+	}
+
+	var authenticMessages []*SecretSharesAccusationsMessage
+	for _, message := range messages {
+		err := mc.verifyAccusationsAuthentication(
+			message.Type(),
+			message.senderID,
+			message.accusedMembersKeys,
+			message.authentication,
+		)
+		if err != nil {
+			logger.Warningf(
+				"[member:%v] rejecting secret shares accusations "+
+					"of member [%v]: [%v]",
+				mc.ID,
+				message.senderID,
+				err,
+			)
+			continue
+		}
+
+		authenticMessages = append(authenticMessages, message)
+	}
+
+	return authenticMessages
+}
+
+// authenticPointsAccusationsMessages returns messages with accusations
+// properly signed by their senders, rejecting unsigned and forged ones. All
+// messages are returned if the member has no signer.
+func (mc *memberCore) authenticPointsAccusationsMessages(
+	messages []*PointsAccusationsMessage,
+) []*PointsAccusationsMessage {
+	if mc.signing == nil {
+		return messages
+	}
+
+	var authenticMessages []*PointsAccusationsMessage
+	for _, message := range messages {
+		err := mc.verifyAccusationsAuthentication(
+			message.Type(),
+			message.senderID,
+			message.accusedMembersKeys,
+			message.authentication,
+		)
+		if err != nil {
+			logger.Warningf(
+				"[member:%v] rejecting points accusations "+
+					"of member [%v]: [%v]",
+				mc.ID,
+				message.senderID,
+				err,
+			)
+			continue
+		}
+
+		authenticMessages = append(authenticMessages, message)
+	}
+
+	return authenticMessages
+}
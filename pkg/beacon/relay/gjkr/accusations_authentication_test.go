@@ -0,0 +1,258 @@
+package gjkr
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"testing"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/operator"
+
+	commonLocal "github.com/keep-network/keep-common/pkg/chain/local"
+)
+
+func TestAuthenticSecretSharesAccusationsMessages(t *testing.T) {
+	groupSize := 3
+
+	signers, membershipValidator, err := initializeSigners(groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parameters := &protocolParameters{seed: big.NewInt(1410)}
+
+	accuser := &memberCore{
+		ID:                 1,
+		protocolParameters: parameters,
+		signing:            signers[0],
+	}
+	accusedMembersKeys, err := generateAccusedMembersKeys(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signedMessage := &SecretSharesAccusationsMessage{
+		senderID:           accuser.ID,
+		accusedMembersKeys: accusedMembersKeys,
+	}
+	signedMessage.authentication, err = accuser.authenticateAccusations(
+		signedMessage.Type(),
+		accusedMembersKeys,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherAccusedMembersKeys, err := generateAccusedMembersKeys(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same accuser signs the same accusations in another key generation.
+	otherSessionAccuser := &memberCore{
+		ID:                 accuser.ID,
+		protocolParameters: &protocolParameters{seed: big.NewInt(1411)},
+		signing:            signers[0],
+	}
+	otherSessionAuthentication, err := otherSessionAccuser.authenticateAccusations(
+		signedMessage.Type(),
+		accusedMembersKeys,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		message       *SecretSharesAccusationsMessage
+		expectedValid bool
+	}{
+		"signed accusations": {
+			message:       signedMessage,
+			expectedValid: true,
+		},
+		"unsigned accusations": {
+			message: &SecretSharesAccusationsMessage{
+				senderID:           accuser.ID,
+				accusedMembersKeys: accusedMembersKeys,
+			},
+			expectedValid: false,
+		},
+		"forged accusations": {
+			message: &SecretSharesAccusationsMessage{
+				senderID:           accuser.ID,
+				accusedMembersKeys: otherAccusedMembersKeys,
+				authentication:     signedMessage.authentication,
+			},
+			expectedValid: false,
+		},
+		"accusations signed in other key generation": {
+			message: &SecretSharesAccusationsMessage{
+				senderID:           accuser.ID,
+				accusedMembersKeys: accusedMembersKeys,
+				authentication:     otherSessionAuthentication,
+			},
+			expectedValid: false,
+		},
+		"accusations signed by other member": {
+			message: &SecretSharesAccusationsMessage{
+				senderID:           3,
+				accusedMembersKeys: accusedMembersKeys,
+				authentication:     signedMessage.authentication,
+			},
+			expectedValid: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			verifier := &memberCore{
+				ID:                  2,
+				membershipValidator: membershipValidator,
+				protocolParameters:  parameters,
+				signing:             signers[1],
+			}
+
+			authenticMessages := verifier.authenticSecretSharesAccusationsMessages(
+				[]*SecretSharesAccusationsMessage{test.message},
+			)
+
+			if test.expectedValid != (len(authenticMessages) == 1) {
+				t.Errorf(
+					"unexpected authentication result\nexpected valid: %v\nactual valid:   %v",
+					test.expectedValid,
+					len(authenticMessages) == 1,
+				)
+			}
+		})
+	}
+}
+
+func TestAuthenticAccusationsMessagesRejectsOtherPhaseAccusations(t *testing.T) {
+	groupSize := 3
+
+	signers, membershipValidator, err := initializeSigners(groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parameters := &protocolParameters{seed: big.NewInt(1410)}
+
+	accuser := &memberCore{
+		ID:                 1,
+		protocolParameters: parameters,
+		signing:            signers[0],
+	}
+	verifier := &memberCore{
+		ID:                  2,
+		membershipValidator: membershipValidator,
+		protocolParameters:  parameters,
+		signing:             signers[1],
+	}
+
+	accusedMembersKeys, err := generateAccusedMembersKeys(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sharesAccusations := &SecretSharesAccusationsMessage{
+		senderID:           accuser.ID,
+		accusedMembersKeys: accusedMembersKeys,
+	}
+	sharesAccusations.authentication, err = accuser.authenticateAccusations(
+		sharesAccusations.Type(),
+		accusedMembersKeys,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Accusations signed in phase 4 can not be presented as phase 8
+	// accusations.
+	replayedAsPointsAccusations := &PointsAccusationsMessage{
+		senderID:           accuser.ID,
+		accusedMembersKeys: accusedMembersKeys,
+		authentication:     sharesAccusations.authentication,
+	}
+	if len(verifier.authenticPointsAccusationsMessages(
+		[]*PointsAccusationsMessage{replayedAsPointsAccusations},
+	)) != 0 {
+		t.Fatalf("expected phase 4 accusations to be rejected in phase 8")
+	}
+
+	pointsAccusations := &PointsAccusationsMessage{
+		senderID:           accuser.ID,
+		accusedMembersKeys: accusedMembersKeys,
+	}
+	pointsAccusations.authentication, err = accuser.authenticateAccusations(
+		pointsAccusations.Type(),
+		accusedMembersKeys,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authenticPointsAccusations := verifier.authenticPointsAccusationsMessages(
+		[]*PointsAccusationsMessage{pointsAccusations},
+	)
+	if len(authenticPointsAccusations) != 1 {
+		t.Fatalf("expected phase 8 accusations to be accepted")
+	}
+}
+
+func TestAuthenticAccusationsMessagesWithoutSigner(t *testing.T) {
+	member := &memberCore{ID: 2}
+
+	messages := []*SecretSharesAccusationsMessage{
+		{senderID: 1},
+		{senderID: 3},
+	}
+
+	authenticMessages := member.authenticSecretSharesAccusationsMessages(
+		messages,
+	)
+	if len(authenticMessages) != len(messages) {
+		t.Fatalf(
+			"unexpected number of messages\nexpected: %v\nactual:   %v",
+			len(messages),
+			len(authenticMessages),
+		)
+	}
+}
+
+// initializeSigners creates a signer with a distinct operator key for each
+// member of the group and a membership validator of that group.
+func initializeSigners(groupSize int) (
+	[]chain.Signing,
+	group.MembershipValidator,
+	error,
+) {
+	signers := make([]chain.Signing, groupSize)
+	stakers := make([]relaychain.StakerAddress, groupSize)
+	for i := range signers {
+		privateKey, _, err := operator.GenerateKeyPair()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signers[i] = commonLocal.NewSigner(privateKey)
+		stakers[i] = signers[i].PublicKeyBytesToAddress(signers[i].PublicKey())
+	}
+
+	return signers, group.NewStakersMembershipValidator(stakers, signers[0]), nil
+}
+
+func generateAccusedMembersKeys(
+	accusedID group.MemberIndex,
+) (map[group.MemberIndex]*ephemeral.PrivateKey, error) {
+	keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[group.MemberIndex]*ephemeral.PrivateKey{
+		accusedID: keyPair.PrivateKey,
+	}, nil
+}
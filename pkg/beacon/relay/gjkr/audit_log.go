@@ -0,0 +1,69 @@
+package gjkr
+
+import (
+	"context"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// AuditLog is an append-only sink of all protocol messages received by
+// a member during the key generation. It lets operators reconstruct what
+// the member saw when analyzing a failed or disputed key generation.
+//
+// Record is called from a single goroutine, in the order in which messages
+// are received from the broadcast channel. Implementations should not block
+// since the member may drop messages delivered by the channel meanwhile.
+type AuditLog interface {
+	Record(entry *AuditLogEntry)
+}
+
+// AuditLogEntry describes a single protocol message received by a member.
+type AuditLogEntry struct {
+	// ID of the member which received the message.
+	ReceiverID group.MemberIndex
+	// ID of the member which sent the message, as declared in the message.
+	SenderID group.MemberIndex
+	// Type of the received message.
+	MessageType string
+	// Time at which the message has been received.
+	ReceivedAt time.Time
+	// The received message.
+	Message group.ProtocolMessage
+}
+
+// noopAuditLog is the default AuditLog discarding all entries.
+type noopAuditLog struct{}
+
+func (nal *noopAuditLog) Record(entry *AuditLogEntry) {}
+
+// recordReceivedMessages registers a handler recording all protocol messages
+// received by the member from the channel in the provided audit log, until
+// the context is done. Messages sent by the member itself are not recorded.
+func recordReceivedMessages(
+	ctx context.Context,
+	memberID group.MemberIndex,
+	channel net.BroadcastChannel,
+	auditLog AuditLog,
+) {
+	channel.Recv(ctx, func(msg net.Message) {
+		protocolMessage, ok := msg.Payload().(group.ProtocolMessage)
+		if !ok || group.IsMessageFromSelf(memberID, protocolMessage) {
+			return
+		}
+
+		messageType := msg.Type()
+		if taggedMessage, ok := protocolMessage.(net.TaggedUnmarshaler); ok {
+			messageType = taggedMessage.Type()
+		}
+
+		auditLog.Record(&AuditLogEntry{
+			ReceiverID:  memberID,
+			SenderID:    protocolMessage.SenderID(),
+			MessageType: messageType,
+			ReceivedAt:  time.Now(),
+			Message:     protocolMessage,
+		})
+	})
+}
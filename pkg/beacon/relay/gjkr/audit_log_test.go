@@ -0,0 +1,199 @@
+package gjkr_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/internal/dkgtest"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+type inMemoryAuditLog struct {
+	mutex   sync.Mutex
+	entries []*gjkr.AuditLogEntry
+}
+
+func (imal *inMemoryAuditLog) Record(entry *gjkr.AuditLogEntry) {
+	imal.mutex.Lock()
+	defer imal.mutex.Unlock()
+
+	imal.entries = append(imal.entries, entry)
+}
+
+func TestExecute_AuditLog(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 3
+	honestThreshold := 2
+	dishonestThreshold := groupSize - honestThreshold
+	seed := dkgtest.RandomSeed(t)
+
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	chain := chainLocal.ConnectWithKey(
+		groupSize,
+		honestThreshold,
+		big.NewInt(20),
+		privateKey,
+	)
+	blockCounter, err := chain.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channel, err := netLocal.ConnectWithKey(networkPublicKey).BroadcastChannelFor(
+		fmt.Sprintf("gjkr-audit-log-test-%v", seed),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gjkr.RegisterUnmarshallers(channel)
+
+	address := chain.Signing().PublicKeyBytesToAddress(
+		key.Marshal(networkPublicKey),
+	)
+	selectedStakers := make([]relaychain.StakerAddress, groupSize)
+	for i := range selectedStakers {
+		selectedStakers[i] = address
+	}
+	membershipValidator := group.NewStakersMembershipValidator(
+		selectedStakers,
+		chain.Signing(),
+	)
+
+	currentBlockHeight, err := blockCounter.CurrentBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	startBlockHeight := currentBlockHeight + 3
+
+	auditLogs := make([]*inMemoryAuditLog, groupSize)
+	errors := make([]error, groupSize)
+
+	var wg sync.WaitGroup
+	wg.Add(groupSize)
+	for i := 0; i < groupSize; i++ {
+		i := i
+		auditLogs[i] = &inMemoryAuditLog{}
+		go func() {
+			defer wg.Done()
+			_, _, errors[i] = gjkr.Execute(
+				context.Background(),
+				group.MemberIndex(i+1),
+				groupSize,
+				blockCounter,
+				channel,
+				dishonestThreshold,
+				0,
+				seed,
+				membershipValidator,
+				chain.Signing(),
+				startBlockHeight,
+				auditLogs[i],
+				nil,
+			)
+		}()
+	}
+	wg.Wait()
+
+	// Messages are expected in the order in which protocol phases send them.
+	// Messages sent in the same phase may arrive in any order.
+	expectedMessageTypes := [][]string{
+		{"gjkr/ephemeral_public_key"},
+		{"gjkr/peer_shares", "gjkr/member_commitments"},
+		{"gjkr/secret_shares_accusations"},
+		{"gjkr/member_public_key_share_points"},
+		{"gjkr/points_accusations_message"},
+		{"gjkr/misbehaved_ephemeral_keys_message"},
+	}
+	phaseOf := func(messageType string) int {
+		for phase, types := range expectedMessageTypes {
+			for _, expectedType := range types {
+				if messageType == expectedType {
+					return phase
+				}
+			}
+		}
+		return -1
+	}
+
+	for i, auditLog := range auditLogs {
+		memberID := group.MemberIndex(i + 1)
+
+		if errors[i] != nil {
+			t.Fatalf("member [%v] failed: [%v]", memberID, errors[i])
+		}
+
+		auditLog.mutex.Lock()
+		entries := auditLog.entries
+		auditLog.mutex.Unlock()
+
+		receivedCounts := make(map[string]map[group.MemberIndex]int)
+		lastPhase := 0
+		for _, entry := range entries {
+			if entry.ReceiverID != memberID {
+				t.Errorf(
+					"unexpected receiver\nexpected: %v\nactual:   %v\n",
+					memberID,
+					entry.ReceiverID,
+				)
+			}
+			if entry.SenderID == memberID {
+				t.Errorf("member [%v] recorded its own message", memberID)
+			}
+			if entry.SenderID != entry.Message.SenderID() {
+				t.Errorf(
+					"unexpected sender\nexpected: %v\nactual:   %v\n",
+					entry.Message.SenderID(),
+					entry.SenderID,
+				)
+			}
+
+			phase := phaseOf(entry.MessageType)
+			if phase < 0 {
+				t.Fatalf("unexpected message type [%v]", entry.MessageType)
+			}
+			if phase < lastPhase {
+				t.Errorf(
+					"member [%v] recorded [%v] message out of order",
+					memberID,
+					entry.MessageType,
+				)
+			}
+			lastPhase = phase
+
+			if receivedCounts[entry.MessageType] == nil {
+				receivedCounts[entry.MessageType] = make(map[group.MemberIndex]int)
+			}
+			receivedCounts[entry.MessageType][entry.SenderID]++
+		}
+
+		for _, types := range expectedMessageTypes {
+			for _, messageType := range types {
+				if len(receivedCounts[messageType]) != groupSize-1 {
+					t.Errorf(
+						"unexpected number of senders of [%v] messages "+
+							"recorded by member [%v]\nexpected: %v\nactual:   %v\n",
+						messageType,
+						memberID,
+						groupSize-1,
+						len(receivedCounts[messageType]),
+					)
+				}
+			}
+		}
+	}
+}
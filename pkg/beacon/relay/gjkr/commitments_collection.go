@@ -0,0 +1,132 @@
+package gjkr
+
+import (
+	"context"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// CollectCommitments gathers commitments messages broadcast by the expected
+// senders over the given channel. It waits until a message from each of the
+// expected senders arrives, at most for the given number of blocks counted
+// from the current block.
+//
+// Collected messages are returned along with the expected senders from which
+// no message arrived within the window, in the order of expected senders.
+// Those senders should be marked as inactive. Only the first message of each
+// expected sender is collected, messages from other senders are ignored.
+// Messages whose network sender is not the member claimed as their sender are
+// ignored as well, so that no one can be reported as missing because someone
+// else sent commitments on its behalf.
+//
+// Unmarshallers of protocol messages must be registered on the channel before
+// calling this function.
+func CollectCommitments(
+	ctx context.Context,
+	channel net.BroadcastChannel,
+	expectedSenders []group.MemberIndex,
+	membershipValidator group.MembershipValidator,
+	blockCounter chain.BlockCounter,
+	timeoutBlocks uint64,
+) ([]*MemberCommitmentsMessage, []group.MemberIndex, error) {
+	currentBlock, err := blockCounter.CurrentBlock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timeoutChannel, err := blockCounter.BlockHeightWaiter(
+		currentBlock + timeoutBlocks,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	receiveChannel := make(chan net.Message, len(expectedSenders))
+	channel.Recv(ctx, func(netMessage net.Message) {
+		select {
+		case receiveChannel <- netMessage:
+		case <-ctx.Done():
+		}
+	})
+
+	isExpectedSender := make(map[group.MemberIndex]bool, len(expectedSenders))
+	for _, senderID := range expectedSenders {
+		isExpectedSender[senderID] = true
+	}
+
+	receivedMessages := make(map[group.MemberIndex]*MemberCommitmentsMessage)
+
+	for len(receivedMessages) < len(isExpectedSender) {
+		select {
+		case netMessage := <-receiveChannel:
+			message, ok := netMessage.Payload().(*MemberCommitmentsMessage)
+			if !ok || !isExpectedSender[message.senderID] {
+				continue
+			}
+
+			if !membershipValidator.IsValidMembership(
+				message.senderID,
+				netMessage.SenderPublicKey(),
+			) {
+				logger.Warningf(
+					"ignoring commitments of member [%v] sent by "+
+						"someone else",
+					message.senderID,
+				)
+				continue
+			}
+
+			if _, ok := receivedMessages[message.senderID]; ok {
+				continue
+			}
+
+			receivedMessages[message.senderID] = message
+		case blockNumber := <-timeoutChannel:
+			logger.Warningf(
+				"commitments collection timed out at block [%v]; "+
+					"received [%v] out of [%v] expected messages",
+				blockNumber,
+				len(receivedMessages),
+				len(isExpectedSender),
+			)
+			messages, missingSenders := collectedCommitments(
+				expectedSenders,
+				receivedMessages,
+			)
+			return messages, missingSenders, nil
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+
+	messages, missingSenders := collectedCommitments(
+		expectedSenders,
+		receivedMessages,
+	)
+	return messages, missingSenders, nil
+}
+
+// collectedCommitments returns messages received from the expected senders
+// and the expected senders from which no message has been received, both in
+// the order of expected senders.
+func collectedCommitments(
+	expectedSenders []group.MemberIndex,
+	receivedMessages map[group.MemberIndex]*MemberCommitmentsMessage,
+) ([]*MemberCommitmentsMessage, []group.MemberIndex) {
+	var messages []*MemberCommitmentsMessage
+	var missingSenders []group.MemberIndex
+	for _, senderID := range expectedSenders {
+		if message, ok := receivedMessages[senderID]; ok {
+			messages = append(messages, message)
+		} else {
+			missingSenders = append(missingSenders, senderID)
+		}
+	}
+
+	return messages, missingSenders
+}
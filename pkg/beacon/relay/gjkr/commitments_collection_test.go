@@ -0,0 +1,173 @@
+package gjkr
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+)
+
+func TestCollectCommitments(t *testing.T) {
+	expectedSenders := []group.MemberIndex{2, 3, 4}
+	timeoutBlocks := uint64(5)
+
+	var tests = map[string]struct {
+		senders                []group.MemberIndex
+		impersonatedSenders    []group.MemberIndex
+		expectedMissingSenders []group.MemberIndex
+	}{
+		"all commitments arrive": {
+			senders:                []group.MemberIndex{4, 2, 3, 3},
+			expectedMissingSenders: nil,
+		},
+		"commitments missing past the window": {
+			senders:                []group.MemberIndex{2, 5, 3},
+			expectedMissingSenders: []group.MemberIndex{4},
+		},
+		"commitments sent on behalf of another member": {
+			senders:                []group.MemberIndex{2, 3, 4},
+			impersonatedSenders:    []group.MemberIndex{3},
+			expectedMissingSenders: []group.MemberIndex{3},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			channel, err := netLocal.Connect().BroadcastChannelFor(
+				fmt.Sprintf("commitments-collection-test-%v", testName),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			RegisterUnmarshallers(channel)
+
+			blockCounter := chainLocal.NewSimulatedBlockCounter()
+
+			membershipValidator := &testMembershipValidator{
+				impersonatedSenders: test.impersonatedSenders,
+			}
+
+			type collectionResult struct {
+				messages       []*MemberCommitmentsMessage
+				missingSenders []group.MemberIndex
+				err            error
+			}
+			resultChannel := make(chan *collectionResult, 1)
+
+			go func() {
+				messages, missingSenders, err := CollectCommitments(
+					context.Background(),
+					channel,
+					expectedSenders,
+					membershipValidator,
+					blockCounter,
+					timeoutBlocks,
+				)
+				resultChannel <- &collectionResult{messages, missingSenders, err}
+			}()
+
+			// Give the collection a moment to start receiving messages.
+			time.Sleep(100 * time.Millisecond)
+
+			for _, senderID := range test.senders {
+				err := channel.Send(
+					context.Background(),
+					newTestCommitmentsMessage(senderID),
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if len(test.expectedMissingSenders) > 0 {
+				// Give the collection a moment to receive sent messages.
+				time.Sleep(100 * time.Millisecond)
+				blockCounter.AdvanceBlocks(int(timeoutBlocks))
+			}
+
+			var result *collectionResult
+			select {
+			case result = <-resultChannel:
+			case <-time.After(5 * time.Second):
+				t.Fatal("commitments collection did not complete")
+			}
+
+			if result.err != nil {
+				t.Fatal(result.err)
+			}
+
+			if !reflect.DeepEqual(
+				test.expectedMissingSenders,
+				result.missingSenders,
+			) {
+				t.Errorf(
+					"unexpected missing senders\nexpected: %v\nactual:   %v",
+					test.expectedMissingSenders,
+					result.missingSenders,
+				)
+			}
+
+			expectedMessagesCount :=
+				len(expectedSenders) - len(test.expectedMissingSenders)
+			if len(result.messages) != expectedMessagesCount {
+				t.Fatalf(
+					"unexpected number of messages\nexpected: %v\nactual:   %v",
+					expectedMessagesCount,
+					len(result.messages),
+				)
+			}
+			for _, message := range result.messages {
+				expectedCommitments :=
+					newTestCommitmentsMessage(message.senderID).commitments
+				if fmt.Sprint(expectedCommitments) !=
+					fmt.Sprint(message.commitments) {
+					t.Errorf(
+						"unexpected commitments from sender [%v]",
+						message.senderID,
+					)
+				}
+			}
+		})
+	}
+}
+
+func newTestCommitmentsMessage(senderID group.MemberIndex) *MemberCommitmentsMessage {
+	return &MemberCommitmentsMessage{
+		senderID: senderID,
+		commitments: []*bn256.G1{
+			new(bn256.G1).ScalarBaseMult(big.NewInt(int64(senderID))),
+		},
+	}
+}
+
+// testMembershipValidator considers every network key valid for any member
+// except for impersonated senders, for which no key is valid.
+type testMembershipValidator struct {
+	impersonatedSenders []group.MemberIndex
+}
+
+func (tmv *testMembershipValidator) IsInGroup(
+	publicKey *ecdsa.PublicKey,
+) bool {
+	return true
+}
+
+func (tmv *testMembershipValidator) IsValidMembership(
+	memberID group.MemberIndex,
+	publicKey []byte,
+) bool {
+	for _, impersonatedSender := range tmv.impersonatedSenders {
+		if impersonatedSender == memberID {
+			return false
+		}
+	}
+	return true
+}
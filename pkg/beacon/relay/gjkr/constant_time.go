@@ -0,0 +1,32 @@
+package gjkr
+
+import (
+	"crypto/subtle"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+// Comparisons of values calculated from member's own secrets, like
+// commitments and public key share points recomputed from shares received
+// from other members, are executed in constant time with the helpers below
+// so that the time of the comparison does not depend on the compared values.
+//
+// The following comparisons are safe to leave variable-time as they involve
+// only public values:
+// - lengths of messages, member indexes and counts of received messages,
+// - ephemeral public keys compared against revealed ephemeral private keys in
+//   ephemeral.PublicKey.IsKeyMatching; the private keys are public once
+//   revealed by an accuser or a revealing member,
+// - group public key and DKG result comparisons done outside of this package.
+
+// constantTimeG1Equal checks if the two G1 points are equal in constant time.
+// Points are compared on their fixed-width marshalled representations.
+func constantTimeG1Equal(a, b *bn256.G1) bool {
+	return subtle.ConstantTimeCompare(a.Marshal(), b.Marshal()) == 1
+}
+
+// constantTimeG2Equal checks if the two G2 points are equal in constant time.
+// Points are compared on their fixed-width marshalled representations.
+func constantTimeG2Equal(a, b *bn256.G2) bool {
+	return subtle.ConstantTimeCompare(a.Marshal(), b.Marshal()) == 1
+}
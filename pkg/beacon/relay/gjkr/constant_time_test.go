@@ -0,0 +1,60 @@
+package gjkr
+
+import (
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+func TestConstantTimePointsEqual(t *testing.T) {
+	var tests = map[string]struct {
+		a *big.Int
+		b *big.Int
+	}{
+		"equal scalars": {
+			a: big.NewInt(1337),
+			b: big.NewInt(1337),
+		},
+		"equal scalars, one of them reduced modulo curve order": {
+			a: big.NewInt(1337),
+			b: new(big.Int).Add(big.NewInt(1337), bn256.Order),
+		},
+		"unequal scalars": {
+			a: big.NewInt(1337),
+			b: big.NewInt(1338),
+		},
+		"unequal scalars of different length": {
+			a: big.NewInt(5),
+			b: new(big.Int).Sub(bn256.Order, big.NewInt(5)),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			reducedA := new(big.Int).Mod(test.a, bn256.Order)
+			reducedB := new(big.Int).Mod(test.b, bn256.Order)
+			expectedResult := reducedA.Cmp(reducedB) == 0
+
+			g1a := new(bn256.G1).ScalarBaseMult(test.a)
+			g1b := new(bn256.G1).ScalarBaseMult(test.b)
+			if result := constantTimeG1Equal(g1a, g1b); result != expectedResult {
+				t.Errorf(
+					"unexpected G1 comparison result\nexpected: %v\nactual:   %v\n",
+					expectedResult,
+					result,
+				)
+			}
+
+			g2a := new(bn256.G2).ScalarBaseMult(test.a)
+			g2b := new(bn256.G2).ScalarBaseMult(test.b)
+			if result := constantTimeG2Equal(g2a, g2b); result != expectedResult {
+				t.Errorf(
+					"unexpected G2 comparison result\nexpected: %v\nactual:   %v\n",
+					expectedResult,
+					result,
+				)
+			}
+		})
+	}
+}
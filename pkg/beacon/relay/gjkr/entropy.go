@@ -0,0 +1,77 @@
+package gjkr
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const (
+	entropyCheckSamplesCount = 4
+	entropyCheckSampleSize   = 32
+	// The expected number of distinct byte values in 32 uniformly random
+	// bytes is above 30. Getting less than 16 from a healthy source is
+	// practically impossible.
+	entropyCheckMinDistinctBytes = 16
+)
+
+// CheckEntropy performs a lightweight sanity check of the given random source
+// before it is used to generate ephemeral keys and polynomial coefficients.
+// It reads a few samples from the source and verifies none of them has
+// suspiciously few distinct byte values and no two of them are the same.
+// The check is meant to catch a broken or misconfigured source, like one
+// returning constant or repeating output; it is not a statistical test of
+// the randomness quality. Returned error wraps ErrLowEntropy if the source
+// failed the check.
+func CheckEntropy(randomSource io.Reader) error {
+	if randomSource == nil {
+		return fmt.Errorf("%w: random source is nil", ErrInvalidConfig)
+	}
+
+	samples := make([][]byte, entropyCheckSamplesCount)
+	for i := range samples {
+		sample := make([]byte, entropyCheckSampleSize)
+		if _, err := io.ReadFull(randomSource, sample); err != nil {
+			return fmt.Errorf("could not read from random source: [%v]", err)
+		}
+
+		if distinctBytes := countDistinctBytes(sample); distinctBytes <
+			entropyCheckMinDistinctBytes {
+			return fmt.Errorf(
+				"%w: sample [%v] has only [%v] distinct byte values; "+
+					"at least [%v] are required",
+				ErrLowEntropy,
+				i,
+				distinctBytes,
+				entropyCheckMinDistinctBytes,
+			)
+		}
+
+		for j := 0; j < i; j++ {
+			if bytes.Equal(samples[j], sample) {
+				return fmt.Errorf(
+					"%w: samples [%v] and [%v] are the same",
+					ErrLowEntropy,
+					j,
+					i,
+				)
+			}
+		}
+
+		samples[i] = sample
+	}
+
+	return nil
+}
+
+func countDistinctBytes(sample []byte) int {
+	var seen [256]bool
+	count := 0
+	for _, b := range sample {
+		if !seen[b] {
+			seen[b] = true
+			count++
+		}
+	}
+	return count
+}
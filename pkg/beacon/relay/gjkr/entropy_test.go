@@ -0,0 +1,84 @@
+package gjkr
+
+import (
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestCheckEntropy(t *testing.T) {
+	var tests = map[string]struct {
+		randomSource  io.Reader
+		expectedError error
+	}{
+		"crypto random source": {
+			randomSource:  crand.Reader,
+			expectedError: nil,
+		},
+		"constant random source": {
+			randomSource:  constantReader(0x2a),
+			expectedError: ErrLowEntropy,
+		},
+		"repeating random source": {
+			randomSource:  &cyclicReader{period: entropyCheckSampleSize},
+			expectedError: ErrLowEntropy,
+		},
+		"nil random source": {
+			randomSource:  nil,
+			expectedError: ErrInvalidConfig,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := CheckEntropy(test.randomSource)
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestCheckEntropyReadFailure(t *testing.T) {
+	err := CheckEntropy(&cyclicReader{period: 256, limit: 40})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if errors.Is(err, ErrLowEntropy) {
+		t.Fatalf("unexpected low entropy error [%v]", err)
+	}
+}
+
+// constantReader returns the same byte value over and over again.
+type constantReader byte
+
+func (cr constantReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(cr)
+	}
+	return len(p), nil
+}
+
+// cyclicReader returns consecutive byte values wrapping around after the given
+// period. If limit is set, reader fails once it returned that many bytes.
+type cyclicReader struct {
+	period int
+	limit  int
+	read   int
+}
+
+func (cr *cyclicReader) Read(p []byte) (int, error) {
+	for i := range p {
+		if cr.limit > 0 && cr.read == cr.limit {
+			return i, io.ErrUnexpectedEOF
+		}
+		p[i] = byte(cr.read % cr.period)
+		cr.read++
+	}
+	return len(p), nil
+}
@@ -0,0 +1,194 @@
+package gjkr
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr/gen/pb"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+// ephemeralKeyStoreDomain separates the key used to encrypt stored ephemeral
+// private keys from any other key derived from the operator's private key.
+const ephemeralKeyStoreDomain = "keep-core/gjkr/ephemeral-key-store"
+
+// EphemeralKeyStore persists ephemeral private keys generated by a member in
+// Phase 1 for each of its peers so that they survive a restart of the client
+// during key generation. Keys are encrypted with a key derived from the
+// operator's static private key before they are passed to the persistence
+// layer so they never reach the disk in plaintext. See
+// LocalMember.SetEphemeralKeyStore.
+type EphemeralKeyStore struct {
+	handle persistence.Handle
+	box    encryption.Box
+}
+
+// NewEphemeralKeyStore creates a new EphemeralKeyStore using the provided
+// persistence handle and encrypting stored keys with a key derived from the
+// operator's static private key.
+func NewEphemeralKeyStore(
+	handle persistence.Handle,
+	operatorPrivateKey *operator.PrivateKey,
+) *EphemeralKeyStore {
+	privateKeyBytes := operatorPrivateKey.D.Bytes()
+	keyBytes := make([]byte, len(ephemeralKeyStoreDomain)+32)
+	copy(keyBytes, ephemeralKeyStoreDomain)
+	copy(keyBytes[len(keyBytes)-len(privateKeyBytes):], privateKeyBytes)
+
+	encryptionKey := sha256.Sum256(keyBytes)
+	zeroize(privateKeyBytes)
+	zeroize(keyBytes)
+
+	return &EphemeralKeyStore{
+		handle: handle,
+		box:    encryption.NewBox(encryptionKey),
+	}
+}
+
+// Save encrypts and persists ephemeral private keys generated by the given
+// member for its peers. Keys are stored in the provided directory which should
+// be unique for the given key generation.
+func (eks *EphemeralKeyStore) Save(
+	directory string,
+	memberID group.MemberIndex,
+	privateKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+) error {
+	marshalledKeys, err := marshalPrivateKeyMap(privateKeys)
+	if err != nil {
+		return fmt.Errorf("could not marshal ephemeral private keys: [%v]", err)
+	}
+
+	plaintext, err := (&pb.MisbehavedEphemeralKeys{
+		SenderID:    uint32(memberID),
+		PrivateKeys: marshalledKeys,
+	}).Marshal()
+	for _, keyBytes := range marshalledKeys {
+		zeroize(keyBytes)
+	}
+	if err != nil {
+		return fmt.Errorf("could not marshal ephemeral private keys: [%v]", err)
+	}
+
+	ciphertext, err := eks.box.Encrypt(plaintext)
+	zeroize(plaintext)
+	if err != nil {
+		return fmt.Errorf("could not encrypt ephemeral private keys: [%v]", err)
+	}
+
+	return eks.handle.Save(
+		ciphertext,
+		directory,
+		"/"+ephemeralKeysFileName(memberID),
+	)
+}
+
+// Load reads and decrypts ephemeral private keys persisted by the given member
+// in the provided directory. An error is returned if the keys could not be
+// found or could not be decrypted.
+func (eks *EphemeralKeyStore) Load(
+	directory string,
+	memberID group.MemberIndex,
+) (map[group.MemberIndex]*ephemeral.PrivateKey, error) {
+	ciphertext, err := eks.read(directory, ephemeralKeysFileName(memberID))
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := eks.box.Decrypt(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt ephemeral private keys: [%v]", err)
+	}
+	defer zeroize(plaintext)
+
+	pbKeys := pb.MisbehavedEphemeralKeys{}
+	if err := pbKeys.Unmarshal(plaintext); err != nil {
+		return nil, fmt.Errorf("could not unmarshal ephemeral private keys: [%v]", err)
+	}
+	defer func() {
+		for _, keyBytes := range pbKeys.PrivateKeys {
+			zeroize(keyBytes)
+		}
+	}()
+
+	if pbKeys.SenderID != uint32(memberID) {
+		return nil, fmt.Errorf(
+			"ephemeral private keys belong to member [%v], not [%v]",
+			pbKeys.SenderID,
+			memberID,
+		)
+	}
+
+	return unmarshalPrivateKeyMap(pbKeys.PrivateKeys)
+}
+
+func (eks *EphemeralKeyStore) read(directory, name string) ([]byte, error) {
+	descriptors, errors := eks.handle.ReadAll()
+
+	var content []byte
+	var readErrors []error
+
+	// Data and errors channels are drained at the same time as we don't know
+	// in what order the persistence layer writes to them.
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for descriptor := range descriptors {
+			if descriptor.Directory() != directory || descriptor.Name() != name {
+				continue
+			}
+
+			data, err := descriptor.Content()
+			if err != nil {
+				readErrors = append(readErrors, err)
+				continue
+			}
+
+			content = data
+		}
+
+		wg.Done()
+	}()
+
+	go func() {
+		for err := range errors {
+			logger.Warningf("could not read from the storage: [%v]", err)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	if len(readErrors) > 0 {
+		return nil, fmt.Errorf(
+			"could not read ephemeral private keys from the storage: [%v]",
+			readErrors[0],
+		)
+	}
+
+	if content == nil {
+		return nil, fmt.Errorf(
+			"no ephemeral private keys for file [%v] in directory [%v]",
+			name,
+			directory,
+		)
+	}
+
+	return content, nil
+}
+
+func ephemeralKeysFileName(memberID group.MemberIndex) string {
+	return fmt.Sprintf("ephemeral_keys_%v", memberID)
+}
+
+func zeroize(bytes []byte) {
+	for i := range bytes {
+		bytes[i] = 0
+	}
+}
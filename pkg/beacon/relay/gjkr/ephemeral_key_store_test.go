@@ -0,0 +1,255 @@
+package gjkr
+
+import (
+	crand "crypto/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+func TestEphemeralKeyStoreRoundtrip(t *testing.T) {
+	operatorPrivateKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKeys := generateEphemeralPrivateKeys(t, 2, 3, 4)
+	handle := newInMemoryPersistence()
+
+	store := NewEphemeralKeyStore(handle, operatorPrivateKey)
+	if err := store.Save("dkg-1", 1, privateKeys); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, data := range handle.data {
+		for _, privateKey := range privateKeys {
+			if strings.Contains(string(data), string(privateKey.Marshal())) {
+				t.Fatal("ephemeral private key persisted in plaintext")
+			}
+		}
+	}
+
+	// Keys should be readable after a restart, with a new store instance.
+	loadedKeys, err := NewEphemeralKeyStore(handle, operatorPrivateKey).Load(
+		"dkg-1",
+		1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(privateKeys, loadedKeys) {
+		t.Fatalf(
+			"unexpected ephemeral private keys\nexpected: %v\nactual:   %v\n",
+			privateKeys,
+			loadedKeys,
+		)
+	}
+}
+
+func TestEphemeralKeyStoreWrongDecryptionKey(t *testing.T) {
+	operatorPrivateKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherOperatorPrivateKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handle := newInMemoryPersistence()
+
+	err = NewEphemeralKeyStore(handle, operatorPrivateKey).Save(
+		"dkg-1",
+		1,
+		generateEphemeralPrivateKeys(t, 2, 3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loadedKeys, err := NewEphemeralKeyStore(handle, otherOperatorPrivateKey).Load(
+		"dkg-1",
+		1,
+	)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if loadedKeys != nil {
+		t.Fatalf("expected no keys, has [%v]", loadedKeys)
+	}
+
+	expectedPrefix := "could not decrypt ephemeral private keys"
+	if !strings.HasPrefix(err.Error(), expectedPrefix) {
+		t.Fatalf(
+			"unexpected error\nexpected prefix: %v\nactual:          %v",
+			expectedPrefix,
+			err,
+		)
+	}
+}
+
+func TestEphemeralKeyStoreNoKeys(t *testing.T) {
+	operatorPrivateKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewEphemeralKeyStore(newInMemoryPersistence(), operatorPrivateKey)
+
+	if _, err := store.Load("dkg-1", 1); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestGenerateSymmetricKeysAfterRestart(t *testing.T) {
+	groupSize := 2
+	dishonestThreshold := 0
+
+	operatorPrivateKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	handle := newInMemoryPersistence()
+
+	members := initializeEphemeralKeyPairMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	member1 := members[0]
+	member2 := members[1]
+
+	err = member1.SetEphemeralKeyStore(
+		NewEphemeralKeyStore(handle, operatorPrivateKey),
+		"dkg-1",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message1, err := member1.GenerateEphemeralKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	message2, err := member2.GenerateEphemeralKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a restart of the first member losing its in-memory keys.
+	member1.ephemeralKeyPairs = make(map[group.MemberIndex]*ephemeral.KeyPair)
+	member1.ephemeralKeyStore = NewEphemeralKeyStore(handle, operatorPrivateKey)
+
+	symmetricKeyMember1 := member1.InitializeSymmetricKeyGeneration()
+	if err := symmetricKeyMember1.GenerateSymmetricKeys(
+		[]*EphemeralPublicKeyMessage{message2},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	symmetricKeyMember2 := member2.InitializeSymmetricKeyGeneration()
+	if err := symmetricKeyMember2.GenerateSymmetricKeys(
+		[]*EphemeralPublicKeyMessage{message1},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext := []byte("shares")
+	ciphertext, err := symmetricKeyMember1.symmetricKeys[member2.ID].Encrypt(
+		plaintext,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := symmetricKeyMember2.symmetricKeys[member1.ID].Decrypt(
+		ciphertext,
+	)
+	if err != nil {
+		t.Fatalf("symmetric keys do not match: [%v]", err)
+	}
+	if string(decrypted) != string(plaintext) {
+		t.Fatalf(
+			"unexpected plaintext\nexpected: %s\nactual:   %s",
+			plaintext,
+			decrypted,
+		)
+	}
+}
+
+func generateEphemeralPrivateKeys(
+	t *testing.T,
+	memberIDs ...group.MemberIndex,
+) map[group.MemberIndex]*ephemeral.PrivateKey {
+	privateKeys := make(map[group.MemberIndex]*ephemeral.PrivateKey)
+	for _, memberID := range memberIDs {
+		keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privateKeys[memberID] = keyPair.PrivateKey
+	}
+	return privateKeys
+}
+
+type inMemoryPersistence struct {
+	data map[string][]byte
+}
+
+func newInMemoryPersistence() *inMemoryPersistence {
+	return &inMemoryPersistence{data: make(map[string][]byte)}
+}
+
+func (imp *inMemoryPersistence) Save(data []byte, directory, name string) error {
+	imp.data[directory+name] = data
+	return nil
+}
+
+func (imp *inMemoryPersistence) Snapshot(data []byte, directory, name string) error {
+	return imp.Save(data, directory, name)
+}
+
+func (imp *inMemoryPersistence) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	outputData := make(chan persistence.DataDescriptor, len(imp.data))
+	outputErrors := make(chan error)
+
+	for path, data := range imp.data {
+		separator := strings.Index(path, "/")
+		outputData <- &inMemoryDataDescriptor{
+			directory: path[:separator],
+			name:      path[separator+1:],
+			content:   data,
+		}
+	}
+
+	close(outputData)
+	close(outputErrors)
+
+	return outputData, outputErrors
+}
+
+func (imp *inMemoryPersistence) Archive(directory string) error {
+	return nil
+}
+
+type inMemoryDataDescriptor struct {
+	directory string
+	name      string
+	content   []byte
+}
+
+func (imdd *inMemoryDataDescriptor) Name() string {
+	return imdd.name
+}
+
+func (imdd *inMemoryDataDescriptor) Directory() string {
+	return imdd.directory
+}
+
+func (imdd *inMemoryDataDescriptor) Content() ([]byte, error) {
+	return imdd.content, nil
+}
@@ -0,0 +1,38 @@
+package gjkr
+
+import "errors"
+
+// Errors returned by the protocol are wrapping one of the following errors
+// so that callers can use errors.Is to tell what kind of failure occurred.
+var (
+	// ErrInvalidConfig is returned when the protocol can not be executed
+	// because of invalid parameters.
+	ErrInvalidConfig = errors.New("invalid configuration")
+
+	// ErrInsufficientShares is returned when there is not enough valid
+	// shares to produce the group key.
+	ErrInsufficientShares = errors.New("insufficient shares")
+
+	// ErrVerificationFailed is returned when a member can not verify data
+	// received from other group members.
+	ErrVerificationFailed = errors.New("verification failed")
+
+	// ErrPhaseTimeout is returned when the protocol did not reach its final
+	// phase before the execution has ended.
+	ErrPhaseTimeout = errors.New("phase timeout")
+
+	// ErrInsufficientOperatingMembers is returned when so many members have
+	// been disqualified or marked as inactive that the remaining operating
+	// members are not able to reconstruct secrets of the group.
+	ErrInsufficientOperatingMembers = errors.New("insufficient operating members")
+
+	// ErrLowEntropy is returned when the random source used to generate
+	// member's secrets does not pass the entropy sanity check.
+	ErrLowEntropy = errors.New("low entropy")
+
+	// ErrTooManyAccusations is returned when members published accusations
+	// against more members than the accusations limit allows. The group is
+	// considered compromised in such case and the protocol is aborted
+	// before resolving the accusations.
+	ErrTooManyAccusations = errors.New("too many accusations")
+)
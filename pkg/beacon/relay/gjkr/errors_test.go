@@ -0,0 +1,486 @@
+package gjkr
+
+import (
+	"context"
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+)
+
+func TestNewMemberInvalidConfig(t *testing.T) {
+	var tests = map[string]struct {
+		memberID           group.MemberIndex
+		groupSize          int
+		dishonestThreshold int
+		seed               *big.Int
+		randomSource       io.Reader
+	}{
+		"zero group size": {
+			memberID:           1,
+			groupSize:          0,
+			dishonestThreshold: 0,
+			seed:               big.NewInt(1),
+			randomSource:       crand.Reader,
+		},
+		"group size overflowing member index": {
+			memberID:           1,
+			groupSize:          256,
+			dishonestThreshold: 1,
+			seed:               big.NewInt(1),
+			randomSource:       crand.Reader,
+		},
+		"negative dishonest threshold": {
+			memberID:           1,
+			groupSize:          3,
+			dishonestThreshold: -1,
+			seed:               big.NewInt(1),
+			randomSource:       crand.Reader,
+		},
+		"dishonest threshold equal to group size": {
+			memberID:           1,
+			groupSize:          3,
+			dishonestThreshold: 3,
+			seed:               big.NewInt(1),
+			randomSource:       crand.Reader,
+		},
+		"zero member index": {
+			memberID:           0,
+			groupSize:          3,
+			dishonestThreshold: 1,
+			seed:               big.NewInt(1),
+			randomSource:       crand.Reader,
+		},
+		"member index out of group": {
+			memberID:           4,
+			groupSize:          3,
+			dishonestThreshold: 1,
+			seed:               big.NewInt(1),
+			randomSource:       crand.Reader,
+		},
+		"nil seed": {
+			memberID:           1,
+			groupSize:          3,
+			dishonestThreshold: 1,
+			seed:               nil,
+			randomSource:       crand.Reader,
+		},
+		"nil random source": {
+			memberID:           1,
+			groupSize:          3,
+			dishonestThreshold: 1,
+			seed:               big.NewInt(1),
+			randomSource:       nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := NewMember(
+				test.memberID,
+				test.groupSize,
+				test.dishonestThreshold,
+				nil,
+				test.seed,
+				test.randomSource,
+				nil,
+			)
+			if !errors.Is(err, ErrInvalidConfig) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					ErrInvalidConfig,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestGroupPublicKeyBytesInsufficientShares(t *testing.T) {
+	_, err := (&Result{}).GroupPublicKeyBytes()
+	if !errors.Is(err, ErrInsufficientShares) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrInsufficientShares,
+			err,
+		)
+	}
+}
+
+func TestVerifyReceivedSharesVerificationFailed(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 3
+
+	members, err := initializeCommittingMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sharesMessage, commitmentsMessage, err :=
+		members[0].CalculateMembersSharesAndCommitments()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifyingMember := members[2].InitializeCommitmentsVerification()
+	delete(verifyingMember.symmetricKeys, members[0].ID)
+
+	_, err = verifyingMember.VerifyReceivedSharesAndCommitmentsMessages(
+		[]*PeerSharesMessage{sharesMessage},
+		[]*MemberCommitmentsMessage{commitmentsMessage},
+	)
+	if !errors.Is(err, ErrVerificationFailed) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrVerificationFailed,
+			err,
+		)
+	}
+}
+
+func TestFinalResultPhaseTimeout(t *testing.T) {
+	_, err := finalResult(
+		&ephemeralKeyPairGenerationState{},
+		&transcriptRecorder{},
+	)
+	if !errors.Is(err, ErrPhaseTimeout) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrPhaseTimeout,
+			err,
+		)
+	}
+}
+
+func TestCalculateMembersSharesAndCommitmentsZeroEvaluationPoint(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 3
+
+	members, err := initializeCommittingMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Index of the last member of a group with 256 members overflows
+	// to 0 which can not be used as an evaluation point.
+	member := members[0]
+	member.group = group.NewDkgGroup(dishonestThreshold, 256)
+
+	_, _, err = member.CalculateMembersSharesAndCommitments()
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrInvalidConfig,
+			err,
+		)
+	}
+}
+
+func TestPointsJustificationInsufficientOperatingMembers(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	var tests = map[string]struct {
+		activeMembersCount  int
+		disqualifiedMembers []group.MemberIndex
+		expectedError       error
+	}{
+		"all members operating": {
+			activeMembersCount: 5,
+			expectedError:      nil,
+		},
+		"dishonest threshold + 1 members operating": {
+			activeMembersCount:  5,
+			disqualifiedMembers: []group.MemberIndex{4, 5},
+			expectedError:       nil,
+		},
+		"dishonest threshold members operating after disqualifications": {
+			activeMembersCount:  5,
+			disqualifiedMembers: []group.MemberIndex{3, 4, 5},
+			expectedError:       ErrInsufficientOperatingMembers,
+		},
+		"dishonest threshold members operating after inactivity": {
+			activeMembersCount: 2,
+			expectedError:      ErrInsufficientOperatingMembers,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializePointsJustifyingMemberGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			member := members[0]
+			for _, disqualifiedMember := range test.disqualifiedMembers {
+				member.group.MarkMemberAsDisqualified(disqualifiedMember)
+			}
+
+			var accusationsMessages []*PointsAccusationsMessage
+			for _, activeMember := range members[:test.activeMembersCount] {
+				accusationsMessages = append(
+					accusationsMessages,
+					&PointsAccusationsMessage{
+						senderID:           activeMember.ID,
+						accusedMembersKeys: make(map[group.MemberIndex]*ephemeral.PrivateKey),
+					},
+				)
+			}
+
+			state := &pointsJustificationState{
+				member:                member,
+				previousPhaseMessages: accusationsMessages,
+			}
+
+			err = state.Initiate(context.Background())
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestSharesJustificationInsufficientOperatingMembers(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	var tests = map[string]struct {
+		activeMembersCount  int
+		disqualifiedMembers []group.MemberIndex
+		expectedError       error
+	}{
+		"all members operating": {
+			activeMembersCount: 5,
+			expectedError:      nil,
+		},
+		"dishonest threshold + 1 members operating": {
+			activeMembersCount:  5,
+			disqualifiedMembers: []group.MemberIndex{4, 5},
+			expectedError:       nil,
+		},
+		"dishonest threshold members operating after disqualifications": {
+			activeMembersCount:  5,
+			disqualifiedMembers: []group.MemberIndex{3, 4, 5},
+			expectedError:       ErrInsufficientOperatingMembers,
+		},
+		"dishonest threshold members operating after inactivity": {
+			activeMembersCount: 2,
+			expectedError:      ErrInsufficientOperatingMembers,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeSharesJustifyingMemberGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			member := members[0]
+			for _, disqualifiedMember := range test.disqualifiedMembers {
+				member.group.MarkMemberAsDisqualified(disqualifiedMember)
+			}
+
+			var accusationsMessages []*SecretSharesAccusationsMessage
+			for _, activeMember := range members[:test.activeMembersCount] {
+				accusationsMessages = append(
+					accusationsMessages,
+					&SecretSharesAccusationsMessage{
+						senderID:           activeMember.ID,
+						accusedMembersKeys: make(map[group.MemberIndex]*ephemeral.PrivateKey),
+					},
+				)
+			}
+
+			state := &sharesJustificationState{
+				member:                           member,
+				previousPhaseAccusationsMessages: accusationsMessages,
+			}
+
+			err = state.Initiate(context.Background())
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestSharesJustificationTooManyAccusations(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	var tests = map[string]struct {
+		accusations             map[group.MemberIndex][]group.MemberIndex
+		maxAccusations          int
+		expectedError           error
+		expectedDisqualifiedIDs []group.MemberIndex
+	}{
+		"accusations against dishonest threshold members": {
+			accusations: map[group.MemberIndex][]group.MemberIndex{
+				2: {1},
+				3: {1, 3},
+			},
+			maxAccusations:          dishonestThreshold,
+			expectedError:           nil,
+			expectedDisqualifiedIDs: []group.MemberIndex{2, 3},
+		},
+		"accusations against more than dishonest threshold members": {
+			accusations: map[group.MemberIndex][]group.MemberIndex{
+				2: {1, 3},
+				3: {4},
+			},
+			maxAccusations: dishonestThreshold,
+			expectedError:  ErrTooManyAccusations,
+		},
+		"accusations against more members with the limit of group size": {
+			accusations: map[group.MemberIndex][]group.MemberIndex{
+				2: {1, 3, 4},
+			},
+			maxAccusations:          groupSize,
+			expectedError:           nil,
+			expectedDisqualifiedIDs: []group.MemberIndex{2},
+		},
+		"accusations against more members than the custom limit": {
+			accusations: map[group.MemberIndex][]group.MemberIndex{
+				2: {1},
+				3: {4},
+			},
+			maxAccusations: 1,
+			expectedError:  ErrTooManyAccusations,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeSharesJustifyingMemberGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			member := members[0]
+			member.maxAccusations = test.maxAccusations
+
+			var accusationsMessages []*SecretSharesAccusationsMessage
+			for _, m := range members[1:] {
+				accusedMembersKeys := make(map[group.MemberIndex]*ephemeral.PrivateKey)
+				for _, accusedID := range test.accusations[m.ID] {
+					// Reveal the actual key so that false accusations of
+					// other members can be resolved.
+					var accusedMemberKey *ephemeral.PrivateKey
+					if keyPair, ok := m.ephemeralKeyPairs[accusedID]; ok {
+						accusedMemberKey = keyPair.PrivateKey
+					}
+					accusedMembersKeys[accusedID] = accusedMemberKey
+				}
+
+				accusationsMessages = append(
+					accusationsMessages,
+					&SecretSharesAccusationsMessage{
+						senderID:           m.ID,
+						accusedMembersKeys: accusedMembersKeys,
+					},
+				)
+			}
+
+			state := &sharesJustificationState{
+				member:                           member,
+				previousPhaseAccusationsMessages: accusationsMessages,
+			}
+
+			err = state.Initiate(context.Background())
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+
+			disqualifiedIDs := member.group.DisqualifiedMemberIDs()
+			if len(test.expectedDisqualifiedIDs) != len(disqualifiedIDs) ||
+				(len(disqualifiedIDs) > 0 &&
+					!reflect.DeepEqual(test.expectedDisqualifiedIDs, disqualifiedIDs)) {
+				t.Fatalf(
+					"unexpected disqualified members\nexpected: %v\nactual:   %v",
+					test.expectedDisqualifiedIDs,
+					disqualifiedIDs,
+				)
+			}
+		})
+	}
+}
+
+func TestPointsJustificationTooManyAccusations(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializePointsJustifyingMemberGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := members[0]
+	member.maxAccusations = dishonestThreshold
+
+	var accusationsMessages []*PointsAccusationsMessage
+	for _, m := range members[1:] {
+		accusedMembersKeys := make(map[group.MemberIndex]*ephemeral.PrivateKey)
+		if m.ID == 2 {
+			accusedMembersKeys[3] = nil
+			accusedMembersKeys[4] = nil
+			accusedMembersKeys[5] = nil
+		}
+
+		accusationsMessages = append(
+			accusationsMessages,
+			&PointsAccusationsMessage{
+				senderID:           m.ID,
+				accusedMembersKeys: accusedMembersKeys,
+			},
+		)
+	}
+
+	state := &pointsJustificationState{
+		member:                member,
+		previousPhaseMessages: accusationsMessages,
+	}
+
+	err = state.Initiate(context.Background())
+	if !errors.Is(err, ErrTooManyAccusations) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrTooManyAccusations,
+			err,
+		)
+	}
+
+	if disqualifiedIDs := member.group.DisqualifiedMemberIDs(); len(disqualifiedIDs) != 0 {
+		t.Fatalf("unexpected disqualified members: %v", disqualifiedIDs)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
 
@@ -85,6 +86,28 @@ func (ssam *SecretSharesAccusationsMessage) SetAccusedMemberKeys(
 	ssam.accusedMembersKeys = accusedMembersKeys
 }
 
+// Sign signs accusations of the message again for the key generation with
+// the given seed, so that accusations altered on behalf of the accuser
+// remain authentic.
+func (ssam *SecretSharesAccusationsMessage) Sign(
+	signing chain.Signing,
+	seed *big.Int,
+) error {
+	authentication, err := resignAccusations(
+		ssam.Type(),
+		seed,
+		ssam.senderID,
+		ssam.accusedMembersKeys,
+		signing,
+	)
+	if err != nil {
+		return err
+	}
+
+	ssam.authentication = authentication
+	return nil
+}
+
 func (mpkspm *MemberPublicKeySharePointsMessage) SetPublicKeyShare(
 	index int,
 	publicKeyShare *bn256.G2,
@@ -114,6 +137,53 @@ func (pam *PointsAccusationsMessage) SetAccusedMemberKeys(
 	pam.accusedMembersKeys = accusedMembersKeys
 }
 
+// Sign signs accusations of the message again for the key generation with
+// the given seed, so that accusations altered on behalf of the accuser
+// remain authentic.
+func (pam *PointsAccusationsMessage) Sign(
+	signing chain.Signing,
+	seed *big.Int,
+) error {
+	authentication, err := resignAccusations(
+		pam.Type(),
+		seed,
+		pam.senderID,
+		pam.accusedMembersKeys,
+		signing,
+	)
+	if err != nil {
+		return err
+	}
+
+	pam.authentication = authentication
+	return nil
+}
+
+func resignAccusations(
+	messageType string,
+	seed *big.Int,
+	senderID group.MemberIndex,
+	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+	signing chain.Signing,
+) (*messageAuthentication, error) {
+	signature, err := signing.Sign(
+		accusationsSignableBytes(
+			messageType,
+			seed,
+			senderID,
+			accusedMembersKeys,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messageAuthentication{
+		signature: signature,
+		publicKey: signing.PublicKey(),
+	}, nil
+}
+
 func (mekm *MisbehavedEphemeralKeysMessage) SetPrivateKey(
 	memberIndex group.MemberIndex,
 	privateKey *ephemeral.PrivateKey,
@@ -241,6 +241,8 @@ func (m *PeerShares_Shares) GetEncryptedShareT() []byte {
 type SecretSharesAccusations struct {
 	SenderID           uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	AccusedMembersKeys map[uint32][]byte `protobuf:"bytes,2,rep,name=accusedMembersKeys,proto3" json:"accusedMembersKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Signature          []byte            `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey          []byte            `protobuf:"bytes,4,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 }
 
 func (m *SecretSharesAccusations) Reset()      { *m = SecretSharesAccusations{} }
@@ -289,6 +291,20 @@ func (m *SecretSharesAccusations) GetAccusedMembersKeys() map[uint32][]byte {
 	return nil
 }
 
+func (m *SecretSharesAccusations) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SecretSharesAccusations) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
 type MemberPublicKeySharePoints struct {
 	SenderID             uint32   `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	PublicKeySharePoints [][]byte `protobuf:"bytes,2,rep,name=publicKeySharePoints,proto3" json:"publicKeySharePoints,omitempty"`
@@ -343,6 +359,8 @@ func (m *MemberPublicKeySharePoints) GetPublicKeySharePoints() [][]byte {
 type PointsAccusations struct {
 	SenderID           uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	AccusedMembersKeys map[uint32][]byte `protobuf:"bytes,2,rep,name=accusedMembersKeys,proto3" json:"accusedMembersKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Signature          []byte            `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey          []byte            `protobuf:"bytes,4,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 }
 
 func (m *PointsAccusations) Reset()      { *m = PointsAccusations{} }
@@ -391,6 +409,20 @@ func (m *PointsAccusations) GetAccusedMembersKeys() map[uint32][]byte {
 	return nil
 }
 
+func (m *PointsAccusations) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *PointsAccusations) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
 type MisbehavedEphemeralKeys struct {
 	SenderID    uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	PrivateKeys map[uint32][]byte `protobuf:"bytes,2,rep,name=privateKeys,proto3" json:"privateKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -461,40 +493,41 @@ func init() {
 func init() { proto.RegisterFile("pb/message.proto", fileDescriptor_8447775385e7eb85) }
 
 var fileDescriptor_8447775385e7eb85 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xcf, 0xa4, 0x5a, 0xe4, 0xa5, 0x62, 0x37, 0x2e, 0xb4, 0x04, 0x19, 0x4a, 0x4f, 0xbd, 0x98,
-	0xc5, 0xaa, 0xb0, 0x78, 0x10, 0x56, 0xad, 0x20, 0xb2, 0x10, 0x53, 0x4f, 0x22, 0x48, 0x92, 0x3e,
-	0xb6, 0x71, 0x9b, 0x3f, 0xcc, 0xa4, 0x85, 0xde, 0xfc, 0x08, 0xfb, 0x31, 0xfc, 0x26, 0x7a, 0xec,
-	0xcd, 0x3d, 0xda, 0xf4, 0xe2, 0x71, 0xbf, 0x80, 0x20, 0x9d, 0x09, 0x6d, 0x68, 0x93, 0x68, 0x4f,
-	0x9e, 0x3a, 0xf3, 0xde, 0xef, 0xfd, 0xde, 0xfb, 0xfd, 0xe6, 0x35, 0xd0, 0x8c, 0xdd, 0x93, 0x00,
-	0x39, 0x77, 0x2e, 0xd0, 0x8c, 0x59, 0x94, 0x44, 0xfa, 0xad, 0x8b, 0xcf, 0x97, 0xac, 0xfb, 0x9b,
-	0x80, 0x3e, 0x88, 0xc7, 0x18, 0x20, 0x73, 0x26, 0xd6, 0xd4, 0x9d, 0xf8, 0xde, 0x5b, 0x9c, 0xeb,
-	0x06, 0xdc, 0xe1, 0x18, 0x8e, 0x90, 0xbd, 0x79, 0xd5, 0x26, 0x1d, 0xd2, 0xbb, 0x6b, 0x6f, 0xee,
-	0x3a, 0x05, 0x60, 0xe8, 0xa1, 0x3f, 0x13, 0x59, 0x55, 0x64, 0x73, 0x11, 0xdd, 0x83, 0xfb, 0xb8,
-	0xc7, 0xc8, 0xdb, 0xb5, 0x4e, 0xad, 0xa7, 0xf5, 0x1f, 0x99, 0xeb, 0xb6, 0xe6, 0x7e, 0xcb, 0x82,
-	0x10, 0x1f, 0x84, 0x09, 0x9b, 0xdb, 0x45, 0x6c, 0xc6, 0x6b, 0x68, 0x97, 0x15, 0xe8, 0x4d, 0xa8,
-	0x5d, 0xe2, 0x3c, 0x9b, 0x7b, 0x7d, 0xd4, 0x8f, 0xe1, 0xf6, 0xcc, 0x99, 0x4c, 0x51, 0x4c, 0xdb,
-	0xb0, 0xe5, 0xe5, 0x99, 0x7a, 0x4a, 0xba, 0xef, 0xe0, 0xe8, 0x1c, 0x03, 0x17, 0xd9, 0xcb, 0x28,
-	0x08, 0xfc, 0x24, 0xc0, 0x30, 0xe1, 0x95, 0xea, 0x3b, 0xa0, 0x79, 0x5b, 0x68, 0x5b, 0xed, 0xd4,
-	0x7a, 0x0d, 0x3b, 0x1f, 0xea, 0x5e, 0xa9, 0x00, 0x16, 0x22, 0x1b, 0x8e, 0x1d, 0x86, 0xd5, 0x64,
-	0x4f, 0xa0, 0xce, 0x05, 0x4a, 0xf0, 0x68, 0xfd, 0x07, 0xd2, 0x9d, 0x6d, 0xb5, 0x29, 0x7f, 0xa4,
-	0x11, 0x19, 0xd6, 0xf8, 0x08, 0xf5, 0x8c, 0xbb, 0x07, 0xf7, 0x30, 0xf4, 0xd8, 0x3c, 0x4e, 0x70,
-	0x24, 0x42, 0x43, 0xd1, 0xa2, 0x61, 0xef, 0x86, 0xf7, 0x91, 0xef, 0x33, 0x2f, 0x76, 0xc3, 0x86,
-	0x0d, 0x5a, 0xae, 0x69, 0x81, 0x99, 0x0f, 0xf3, 0x66, 0x6a, 0xfd, 0x56, 0xc9, 0xcc, 0x79, 0x97,
-	0x57, 0x04, 0x5a, 0x43, 0xf4, 0x18, 0x26, 0x32, 0x77, 0xe6, 0x79, 0x53, 0xee, 0x24, 0x7e, 0x14,
-	0x56, 0xfb, 0x83, 0xa0, 0x3b, 0x6b, 0x28, 0x8e, 0xe4, 0x23, 0x71, 0xb1, 0x49, 0xd2, 0xab, 0xa7,
-	0xb2, 0x6f, 0x09, 0xad, 0x79, 0xb6, 0x57, 0x27, 0x4d, 0x2c, 0x20, 0x34, 0x06, 0xd0, 0x2a, 0x81,
-	0x1f, 0xb4, 0x4b, 0x13, 0x30, 0x64, 0xfd, 0x66, 0x21, 0xc5, 0x58, 0x56, 0xe4, 0xff, 0x6d, 0xa9,
-	0xfa, 0x70, 0x1c, 0x17, 0xd4, 0x64, 0xdb, 0x55, 0x98, 0xeb, 0xfe, 0x20, 0x70, 0x24, 0x8f, 0xff,
-	0xea, 0xe6, 0xa7, 0x0a, 0x37, 0x4f, 0xb2, 0x57, 0xdc, 0x25, 0xfc, 0x1f, 0x3e, 0x7e, 0x23, 0xd0,
-	0x3a, 0xf7, 0xb9, 0x8b, 0x63, 0x67, 0x86, 0xa3, 0xcd, 0xdf, 0x7c, 0x4d, 0x56, 0xa9, 0xcf, 0x02,
-	0x2d, 0x66, 0xfe, 0xcc, 0x49, 0x30, 0x27, 0xcc, 0x94, 0xc2, 0x4a, 0xf8, 0x4c, 0x6b, 0x5b, 0x20,
-	0x75, 0xe5, 0x29, 0x8c, 0xe7, 0xd0, 0xdc, 0x05, 0x1c, 0xa2, 0xe4, 0xc5, 0xe9, 0x62, 0x49, 0x95,
-	0xeb, 0x25, 0x55, 0x6e, 0x96, 0x94, 0x7c, 0x49, 0x29, 0xf9, 0x9a, 0x52, 0xf2, 0x3d, 0xa5, 0x64,
-	0x91, 0x52, 0xf2, 0x33, 0xa5, 0xe4, 0x57, 0x4a, 0x95, 0x9b, 0x94, 0x92, 0xab, 0x15, 0x55, 0x16,
-	0x2b, 0xaa, 0x5c, 0xaf, 0xa8, 0xf2, 0x41, 0x8d, 0x5d, 0xb7, 0x2e, 0x3e, 0xd2, 0x8f, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xa7, 0xda, 0x14, 0x01, 0xb8, 0x05, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0x9d, 0x12, 0xc1, 0xb8, 0x88, 0x74, 0x5b, 0x29, 0x91, 0x55, 0x59, 0x51, 0x4e, 0xb9,
+	0xe0, 0x8a, 0x00, 0x52, 0xd5, 0x03, 0x52, 0x81, 0x20, 0x21, 0x54, 0xc9, 0x38, 0x9c, 0x10, 0x12,
+	0xb2, 0x9d, 0x51, 0xe2, 0x36, 0xfe, 0xd1, 0xae, 0x13, 0x29, 0x37, 0x1e, 0xa1, 0x12, 0x0f, 0xc0,
+	0x95, 0x37, 0x81, 0x63, 0x8e, 0x3d, 0xd2, 0x72, 0xe1, 0xc8, 0x0b, 0x20, 0xb1, 0xde, 0x35, 0x89,
+	0x95, 0xd8, 0x86, 0x1e, 0x39, 0x8c, 0x76, 0xf7, 0x9b, 0x99, 0x6f, 0x66, 0xbf, 0x1d, 0xd9, 0xd0,
+	0x88, 0xdd, 0xc3, 0x00, 0x19, 0x73, 0x46, 0x68, 0xc6, 0x34, 0x4a, 0x22, 0xb2, 0x3d, 0x3a, 0x3b,
+	0xa7, 0x9d, 0x5f, 0x0a, 0x90, 0x7e, 0x3c, 0xc6, 0x00, 0xa9, 0x33, 0xb1, 0xa6, 0xee, 0xc4, 0xf7,
+	0x5e, 0xe1, 0x9c, 0xe8, 0x70, 0x9b, 0x61, 0x38, 0x44, 0xfa, 0xf2, 0x79, 0x4b, 0x69, 0x2b, 0xdd,
+	0xbb, 0xf6, 0xf2, 0x4c, 0x0c, 0x00, 0x8a, 0x1e, 0xfa, 0x33, 0xe1, 0x55, 0x85, 0x37, 0x87, 0x10,
+	0x0f, 0xf6, 0x70, 0x83, 0x91, 0xb5, 0x6a, 0xed, 0x5a, 0x57, 0xeb, 0x3d, 0x30, 0xd3, 0xb2, 0xe6,
+	0x66, 0xc9, 0x02, 0x88, 0xf5, 0xc3, 0x84, 0xce, 0xed, 0x22, 0x36, 0xfd, 0x05, 0xb4, 0xca, 0x12,
+	0x48, 0x03, 0x6a, 0xe7, 0x38, 0xcf, 0xfa, 0x4e, 0xb7, 0x64, 0x1f, 0x6e, 0xcd, 0x9c, 0xc9, 0x14,
+	0x45, 0xb7, 0x3b, 0xb6, 0x3c, 0x1c, 0xab, 0x47, 0x4a, 0xe7, 0x35, 0xec, 0x9e, 0x62, 0xe0, 0x22,
+	0x7d, 0x16, 0x05, 0x81, 0x9f, 0x04, 0x18, 0x26, 0xac, 0xf2, 0xf6, 0x6d, 0xd0, 0xbc, 0x55, 0x28,
+	0x27, 0xac, 0x71, 0xc2, 0x3c, 0xd4, 0xb9, 0x50, 0x01, 0x2c, 0x44, 0x3a, 0x18, 0x3b, 0x14, 0xab,
+	0xc9, 0x1e, 0x41, 0x9d, 0x89, 0x28, 0xc1, 0xa3, 0xf5, 0x0e, 0xa4, 0x3a, 0xab, 0x6c, 0x53, 0x2e,
+	0x52, 0x88, 0x2c, 0x56, 0x7f, 0x07, 0xf5, 0x8c, 0xbb, 0x0b, 0xf7, 0x30, 0xf4, 0xe8, 0x3c, 0x4e,
+	0x70, 0x28, 0xa0, 0x81, 0x28, 0xb1, 0x63, 0xaf, 0xc3, 0x9b, 0x91, 0x6f, 0x32, 0x2d, 0xd6, 0x61,
+	0xdd, 0x06, 0x2d, 0x57, 0xb4, 0x40, 0xcc, 0xfb, 0x79, 0x31, 0xb5, 0x5e, 0xb3, 0xa4, 0xe7, 0xbc,
+	0xca, 0x9f, 0x54, 0x68, 0x0e, 0xd0, 0xa3, 0x98, 0x48, 0xdf, 0x89, 0xe7, 0x4d, 0x99, 0x93, 0xf8,
+	0x51, 0x58, 0xad, 0x0f, 0x02, 0x71, 0xd2, 0x50, 0x1c, 0xca, 0x47, 0x62, 0x62, 0x92, 0xa4, 0x56,
+	0x8f, 0x65, 0xdd, 0x12, 0x5a, 0xf3, 0x64, 0x23, 0x4f, 0x8a, 0x58, 0x40, 0x48, 0x0e, 0xe0, 0x0e,
+	0xf3, 0x47, 0xa1, 0x93, 0x4c, 0x29, 0xf2, 0x39, 0x4d, 0x65, 0x59, 0x01, 0xa9, 0x37, 0xfe, 0x33,
+	0x61, 0xad, 0x6d, 0xe9, 0x5d, 0x02, 0x7a, 0x1f, 0x9a, 0x25, 0xa5, 0x6e, 0x34, 0x87, 0x13, 0xd0,
+	0x65, 0xfe, 0x72, 0x98, 0xc5, 0x95, 0xac, 0xc8, 0xff, 0xdb, 0x40, 0xf6, 0x60, 0x3f, 0x2e, 0xc8,
+	0xc9, 0x26, 0xb3, 0xd0, 0xd7, 0xf9, 0xa8, 0xc2, 0xae, 0xdc, 0xfe, 0xeb, 0x4b, 0xbc, 0xaf, 0x78,
+	0x89, 0xc3, 0x6c, 0x02, 0xd6, 0x09, 0xff, 0xb7, 0x37, 0xf8, 0xa2, 0x40, 0xf3, 0xd4, 0x67, 0x2e,
+	0x8e, 0x9d, 0x19, 0x0e, 0x97, 0x9f, 0x17, 0xd1, 0x5e, 0x95, 0x36, 0x16, 0x68, 0x31, 0xf5, 0x67,
+	0x4e, 0x82, 0x39, 0x51, 0x4c, 0x29, 0x4a, 0x09, 0x9f, 0x69, 0xad, 0x12, 0xa4, 0x26, 0x79, 0x0a,
+	0xfd, 0x09, 0x34, 0xd6, 0x03, 0x6e, 0x72, 0x93, 0xa7, 0x47, 0x8b, 0x2b, 0x63, 0xeb, 0x92, 0xdb,
+	0xcf, 0x2b, 0x43, 0xf9, 0x70, 0x6d, 0x28, 0x9f, 0xb9, 0x7d, 0xe5, 0xb6, 0xe0, 0xf6, 0x8d, 0xdb,
+	0x8f, 0x6b, 0xee, 0xe3, 0xeb, 0xc5, 0x77, 0x63, 0x6b, 0xc1, 0xed, 0x92, 0xdb, 0x5b, 0x35, 0x76,
+	0xdd, 0xba, 0xf8, 0x39, 0x3c, 0xfc, 0x0d, 0xd0, 0xb1, 0xfc, 0xd1, 0x30, 0x06, 0x00, 0x00,
 }
 
 func (this *EphemeralPublicKey) Equal(that interface{}) bool {
@@ -653,6 +686,12 @@ func (this *SecretSharesAccusations) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
 	return true
 }
 func (this *MemberPublicKeySharePoints) Equal(that interface{}) bool {
@@ -717,6 +756,12 @@ func (this *PointsAccusations) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
 	return true
 }
 func (this *MisbehavedEphemeralKeys) Equal(that interface{}) bool {
@@ -824,7 +869,7 @@ func (this *SecretSharesAccusations) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.SecretSharesAccusations{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	keysForAccusedMembersKeys := make([]uint32, 0, len(this.AccusedMembersKeys))
@@ -840,6 +885,8 @@ func (this *SecretSharesAccusations) GoString() string {
 	if this.AccusedMembersKeys != nil {
 		s = append(s, "AccusedMembersKeys: "+mapStringForAccusedMembersKeys+",\n")
 	}
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -858,7 +905,7 @@ func (this *PointsAccusations) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.PointsAccusations{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	keysForAccusedMembersKeys := make([]uint32, 0, len(this.AccusedMembersKeys))
@@ -874,6 +921,8 @@ func (this *PointsAccusations) GoString() string {
 	if this.AccusedMembersKeys != nil {
 		s = append(s, "AccusedMembersKeys: "+mapStringForAccusedMembersKeys+",\n")
 	}
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1106,6 +1155,20 @@ func (m *SecretSharesAccusations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AccusedMembersKeys) > 0 {
 		for k := range m.AccusedMembersKeys {
 			v := m.AccusedMembersKeys[k]
@@ -1190,6 +1253,20 @@ func (m *PointsAccusations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AccusedMembersKeys) > 0 {
 		for k := range m.AccusedMembersKeys {
 			v := m.AccusedMembersKeys[k]
@@ -1383,6 +1460,14 @@ func (m *SecretSharesAccusations) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -1425,6 +1510,14 @@ func (m *PointsAccusations) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -1540,6 +1633,8 @@ func (this *SecretSharesAccusations) String() string {
 	s := strings.Join([]string{`&SecretSharesAccusations{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`AccusedMembersKeys:` + mapStringForAccusedMembersKeys + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1572,6 +1667,8 @@ func (this *PointsAccusations) String() string {
 	s := strings.Join([]string{`&PointsAccusations{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`AccusedMembersKeys:` + mapStringForAccusedMembersKeys + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2384,6 +2481,74 @@ func (m *SecretSharesAccusations) Unmarshal(dAtA []byte) error {
 			}
 			m.AccusedMembersKeys[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2674,6 +2839,74 @@ func (m *PointsAccusations) Unmarshal(dAtA []byte) error {
 			}
 			m.AccusedMembersKeys[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
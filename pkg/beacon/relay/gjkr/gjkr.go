@@ -1,6 +1,8 @@
 package gjkr
 
 import (
+	"context"
+	crand "crypto/rand"
 	"fmt"
 	"math/big"
 
@@ -55,15 +57,34 @@ func RegisterUnmarshallers(channel net.BroadcastChannel) {
 // If the generation is successful, it returns a threshold group member which
 // can participate in the signing group; if the generation fails, it returns an
 // error.
+// Execution is stopped as soon as the provided context is done. Member's
+// secrets are wiped in such case and an error wrapping the context error is
+// returned.
+// All protocol messages received by the member are recorded in the provided
+// audit log. If the audit log is nil, received messages are not recorded.
+// Independently, the result contains a transcript of the key generation
+// which can be verified with VerifyTranscript.
+// The provided progress callback is notified each time the member completes
+// a protocol phase. If the callback is nil, progress is not reported.
+// Accusations published by the member are signed with the provided signing
+// and accusations published by other members are verified with it.
+// The protocol is aborted if more than maxAccusations distinct members are
+// accused in phase 4 or phase 8. If maxAccusations is zero, the limit is equal
+// to the dishonest threshold.
 func Execute(
+	ctx context.Context,
 	memberIndex group.MemberIndex,
 	groupSize int,
 	blockCounter chain.BlockCounter,
 	channel net.BroadcastChannel,
 	dishonestThreshold int,
+	maxAccusations int,
 	seed *big.Int,
 	membershipValidator group.MembershipValidator,
+	signing chain.Signing,
 	startBlockHeight uint64,
+	auditLog AuditLog,
+	progressCallback ProgressCallback,
 ) (*Result, uint64, error) {
 	logger.Debugf("[member:%v] initializing member", memberIndex)
 
@@ -73,27 +94,87 @@ func Execute(
 		dishonestThreshold,
 		membershipValidator,
 		seed,
+		crand.Reader,
+		progressCallback,
 	)
 	if err != nil {
-		return nil, 0, fmt.Errorf("cannot create a new member: [%v]", err)
+		return nil, 0, fmt.Errorf("cannot create a new member: [%w]", err)
+	}
+	if err := member.SetSigning(signing); err != nil {
+		return nil, 0, fmt.Errorf("cannot create a new member: [%w]", err)
+	}
+	if maxAccusations != 0 {
+		if err := member.SetMaxAccusations(maxAccusations); err != nil {
+			return nil, 0, fmt.Errorf("cannot create a new member: [%w]", err)
+		}
 	}
 
+	return execute(ctx, member, blockCounter, channel, startBlockHeight, auditLog)
+}
+
+// execute runs all phases of the protocol for the given member on the state
+// machine, starting at the given block height.
+func execute(
+	ctx context.Context,
+	member *LocalMember,
+	blockCounter chain.BlockCounter,
+	channel net.BroadcastChannel,
+	startBlockHeight uint64,
+	auditLog AuditLog,
+) (*Result, uint64, error) {
 	initialState := &ephemeralKeyPairGenerationState{
 		channel: channel,
 		member:  member.InitializeEphemeralKeysGeneration(),
 	}
 
+	if auditLog == nil {
+		auditLog = &noopAuditLog{}
+	}
+
+	auditCtx, cancelAudit := context.WithCancel(ctx)
+	defer cancelAudit()
+	recordReceivedMessages(auditCtx, member.ID, channel, auditLog)
+
+	transcriptRecorder := &transcriptRecorder{}
+	recordTranscriptMessages(auditCtx, member.ID, channel, transcriptRecorder)
+
 	stateMachine := state.NewMachine(channel, blockCounter, initialState)
 
-	lastState, endBlockHeight, err := stateMachine.Execute(startBlockHeight)
+	lastState, endBlockHeight, err := stateMachine.Execute(ctx, startBlockHeight)
+	if err != nil {
+		if lastState != nil {
+			wipeStateSecrets(lastState)
+		}
+		return nil, 0, err
+	}
+
+	result, err := finalResult(lastState, transcriptRecorder)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	return result, endBlockHeight, nil
+}
+
+// finalResult returns the result of the protocol along with its transcript
+// if the execution ended on the final state of the protocol.
+func finalResult(
+	lastState state.State,
+	transcriptRecorder *transcriptRecorder,
+) (*Result, error) {
 	finalizationState, ok := lastState.(*finalizationState)
 	if !ok {
-		return nil, 0, fmt.Errorf("execution ended on state: %T", lastState)
+		return nil, fmt.Errorf(
+			"%w: execution ended on state: %T",
+			ErrPhaseTimeout,
+			lastState,
+		)
 	}
 
-	return finalizationState.result(), endBlockHeight, nil
+	transcript := transcriptRecorder.transcript(finalizationState.member)
+
+	result := finalizationState.result()
+	result.Transcript = transcript
+
+	return result, nil
 }
@@ -0,0 +1,171 @@
+package gjkr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/internal/dkgtest"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+func TestExecute_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 3
+	honestThreshold := 2
+	dishonestThreshold := groupSize - honestThreshold
+	seed := dkgtest.RandomSeed(t)
+
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	chain := chainLocal.ConnectWithKey(
+		groupSize,
+		honestThreshold,
+		big.NewInt(20),
+		privateKey,
+	)
+	blockCounter, err := chain.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channel, err := netLocal.ConnectWithKey(networkPublicKey).BroadcastChannelFor(
+		fmt.Sprintf("gjkr-cancel-test-%v", seed),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gjkr.RegisterUnmarshallers(channel)
+
+	address := chain.Signing().PublicKeyBytesToAddress(
+		key.Marshal(networkPublicKey),
+	)
+	selectedStakers := make([]relaychain.StakerAddress, groupSize)
+	for i := range selectedStakers {
+		selectedStakers[i] = address
+	}
+	membershipValidator := group.NewStakersMembershipValidator(
+		selectedStakers,
+		chain.Signing(),
+	)
+
+	currentBlockHeight, err := blockCounter.CurrentBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	startBlockHeight := currentBlockHeight + 3
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	// Cancel the execution as soon as the first member completes phase 1.
+	progressCallback := func(phase int, receivedCount int, expectedCount int) {
+		if phase == 1 {
+			cancelCtx()
+		}
+	}
+
+	results := make([]*gjkr.Result, groupSize)
+	errs := make([]error, groupSize)
+
+	var wg sync.WaitGroup
+	wg.Add(groupSize)
+	for i := 0; i < groupSize; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			results[i], _, errs[i] = gjkr.Execute(
+				ctx,
+				group.MemberIndex(i+1),
+				groupSize,
+				blockCounter,
+				channel,
+				dishonestThreshold,
+				0,
+				seed,
+				membershipValidator,
+				chain.Signing(),
+				startBlockHeight,
+				nil,
+				progressCallback,
+			)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("execution has not been stopped after cancellation")
+	}
+
+	for i := range errs {
+		if !errors.Is(errs[i], context.Canceled) {
+			t.Errorf(
+				"unexpected error of member [%v]\nexpected: %v\nactual:   %v\n",
+				i+1,
+				context.Canceled,
+				errs[i],
+			)
+		}
+		if results[i] != nil {
+			t.Errorf("unexpected result of member [%v]", i+1)
+		}
+	}
+}
+
+func TestExecute_InvalidMaxAccusations(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 3
+	honestThreshold := 2
+	dishonestThreshold := groupSize - honestThreshold
+
+	chain := chainLocal.Connect(groupSize, honestThreshold, big.NewInt(20))
+
+	result, _, err := gjkr.Execute(
+		context.Background(),
+		group.MemberIndex(1),
+		groupSize,
+		nil,
+		nil,
+		dishonestThreshold,
+		groupSize+1,
+		big.NewInt(1),
+		nil,
+		chain.Signing(),
+		0,
+		nil,
+		nil,
+	)
+	if !errors.Is(err, gjkr.ErrInvalidConfig) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			gjkr.ErrInvalidConfig,
+			err,
+		)
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+	}
+}
@@ -3,6 +3,7 @@
 package gjkr_test
 
 import (
+	crand "crypto/rand"
 	"math/big"
 	"sync"
 	"testing"
@@ -11,9 +12,14 @@ import (
 	"github.com/keep-network/keep-core/pkg/altbn128"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/internal/dkgtest"
+	"github.com/keep-network/keep-core/pkg/internal/interception"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/operator"
+
+	commonLocal "github.com/keep-network/keep-common/pkg/chain/local"
 )
 
 func TestExecute_HappyPath(t *testing.T) {
@@ -349,6 +355,7 @@ func TestExecute_DQ_member3_revealsWrongPrivateKey_phase5(t *testing.T) {
 	groupSize := 5
 	honestThreshold := 3
 	seed := dkgtest.RandomSeed(t)
+	operatorKey, signing := newOperatorSigning(t)
 
 	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
 		accusationsMessage, ok := msg.(*gjkr.SecretSharesAccusationsMessage)
@@ -356,18 +363,25 @@ func TestExecute_DQ_member3_revealsWrongPrivateKey_phase5(t *testing.T) {
 			// accuser (member 3) reveals a random private key which doesn't
 			// correspond to the previously broadcast public key
 			// generated for the sake of communication with the member 1
-			randomKeyPair, _ := ephemeral.GenerateKeyPair()
+			randomKeyPair, _ := ephemeral.GenerateKeyPair(crand.Reader)
 			accusationsMessage.SetAccusedMemberKey(
 				group.MemberIndex(1),
 				randomKeyPair.PrivateKey,
 			)
+			_ = accusationsMessage.Sign(signing, seed)
 			return accusationsMessage
 		}
 
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -496,13 +510,20 @@ func TestExecute_DQ_member4_falseAccusation_phase5(t *testing.T) {
 			accusedMembersKeys[group.MemberIndex(1)] =
 				manInTheMiddle.ephemeralKeyPairs[group.MemberIndex(1)].PrivateKey
 			accusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+			_ = accusationsMessage.Sign(manInTheMiddle.signing, seed)
 			return accusationsMessage
 		}
 
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -556,13 +577,20 @@ func TestExecute_DQ_member2_accusesInactiveMember_phase5(t *testing.T) {
 			accusedMembersKeys[group.MemberIndex(1)] =
 				manInTheMiddle.ephemeralKeyPairs[group.MemberIndex(1)].PrivateKey
 			accusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+			_ = accusationsMessage.Sign(manInTheMiddle.signing, seed)
 			return accusationsMessage
 		}
 
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -622,11 +650,12 @@ func TestExecute_DQ_members25_revealWrongPrivateKey_phase9(t *testing.T) {
 	groupSize := 7
 	honestThreshold := 4
 	seed := dkgtest.RandomSeed(t)
+	operatorKey, signing := newOperatorSigning(t)
 
 	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
 		accusationsMessage, ok := msg.(*gjkr.PointsAccusationsMessage)
 		if ok && accusationsMessage.SenderID() == group.MemberIndex(2) {
-			randomKeyPair, _ := ephemeral.GenerateKeyPair()
+			randomKeyPair, _ := ephemeral.GenerateKeyPair(crand.Reader)
 			accusationsMessage.SetAccusedMemberKey(
 				group.MemberIndex(1),
 				randomKeyPair.PrivateKey,
@@ -635,22 +664,30 @@ func TestExecute_DQ_members25_revealWrongPrivateKey_phase9(t *testing.T) {
 				group.MemberIndex(3),
 				randomKeyPair.PrivateKey,
 			)
+			_ = accusationsMessage.Sign(signing, seed)
 			return accusationsMessage
 		}
 
 		if ok && accusationsMessage.SenderID() == group.MemberIndex(5) {
-			randomKeyPair, _ := ephemeral.GenerateKeyPair()
+			randomKeyPair, _ := ephemeral.GenerateKeyPair(crand.Reader)
 			accusationsMessage.SetAccusedMemberKey(
 				group.MemberIndex(4),
 				randomKeyPair.PrivateKey,
 			)
+			_ = accusationsMessage.Sign(signing, seed)
 			return accusationsMessage
 		}
 
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -742,6 +779,7 @@ func TestExecute_DQ_member4_falseAccusation_phase9(t *testing.T) {
 			accusedMembersKeys[group.MemberIndex(1)] =
 				manInTheMiddle.ephemeralKeyPairs[group.MemberIndex(1)].PrivateKey
 			pointsAccusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+			_ = pointsAccusationsMessage.Sign(manInTheMiddle.signing, seed)
 			return pointsAccusationsMessage
 		}
 
@@ -750,7 +788,13 @@ func TestExecute_DQ_member4_falseAccusation_phase9(t *testing.T) {
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -795,6 +839,7 @@ func TestExecute_DQ_member2_accusesInactiveMember_phase9(t *testing.T) {
 			accusedMembersKeys[group.MemberIndex(1)] =
 				manInTheMiddle.ephemeralKeyPairs[group.MemberIndex(1)].PrivateKey
 			pointsAccusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+			_ = pointsAccusationsMessage.Sign(manInTheMiddle.signing, seed)
 			return pointsAccusationsMessage
 		}
 
@@ -809,7 +854,13 @@ func TestExecute_DQ_member2_accusesInactiveMember_phase9(t *testing.T) {
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -856,6 +907,7 @@ func TestExecute_DQ_members12_cannotDecryptTheirShares_phase9(t *testing.T) {
 			accusedMembersKeys[group.MemberIndex(2)] =
 				manInTheMiddle.ephemeralKeyPairs[group.MemberIndex(2)].PrivateKey
 			pointsAccusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+			_ = pointsAccusationsMessage.Sign(manInTheMiddle.signing, seed)
 			return pointsAccusationsMessage
 		}
 
@@ -876,7 +928,13 @@ func TestExecute_DQ_members12_cannotDecryptTheirShares_phase9(t *testing.T) {
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -956,7 +1014,7 @@ func TestExecute_DQ_member2_revealedKeyOfOperatingMember_phase11(t *testing.T) {
 	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
 		misbehavedKeysMessage, ok := msg.(*gjkr.MisbehavedEphemeralKeysMessage)
 		if ok && misbehavedKeysMessage.SenderID() == group.MemberIndex(2) {
-			randomKeyPair, _ := ephemeral.GenerateKeyPair()
+			randomKeyPair, _ := ephemeral.GenerateKeyPair(crand.Reader)
 			misbehavedKeysMessage.SetPrivateKey(
 				group.MemberIndex(3),
 				randomKeyPair.PrivateKey,
@@ -1012,7 +1070,7 @@ func TestExecute_DQ_member5_revealsWrongPrivateKey_phase11(t *testing.T) {
 		// reveals some other key. As a result, member 5 should be disqualified.
 		misbehavedKeysMessage, ok := msg.(*gjkr.MisbehavedEphemeralKeysMessage)
 		if ok && misbehavedKeysMessage.SenderID() == group.MemberIndex(5) {
-			randomKeyPair, _ := ephemeral.GenerateKeyPair()
+			randomKeyPair, _ := ephemeral.GenerateKeyPair(crand.Reader)
 			misbehavedKeysMessage.SetPrivateKey(
 				group.MemberIndex(4),
 				randomKeyPair.PrivateKey,
@@ -1083,7 +1141,13 @@ func TestExecute_DQ_member2_revealsInactiveNonQualMemberKey_phase11(t *testing.T
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1132,6 +1196,7 @@ func TestExecute_DQ_member3_revealsDisqualifiedNonQualMemberKey_phase11(t *testi
 			accusedMembersKeys[group.MemberIndex(4)] =
 				manInTheMiddle.ephemeralKeyPairs[group.MemberIndex(4)].PrivateKey
 			accusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+			_ = accusationsMessage.Sign(manInTheMiddle.signing, seed)
 			return accusationsMessage
 		}
 
@@ -1163,7 +1228,13 @@ func TestExecute_DQ_member3_revealsDisqualifiedNonQualMemberKey_phase11(t *testi
 		return msg
 	}
 
-	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	result, err := dkgtest.RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		interceptor,
+		manInTheMiddle.operatorKey,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1211,6 +1282,102 @@ func TestExecute_InvalidMemberIndex(t *testing.T) {
 	dkgtest.AssertResultSupportingMembers(t, result, []group.MemberIndex{2, 3, 4, 5}...)
 }
 
+// Test cases driving disqualification paths with misbehaving members built
+// by the dishonest member toolkit. In each case all misbehaving members are
+// expected to be identified and excluded from the group by honest members.
+func TestExecute_MisbehavingMembers(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		groupSize           int
+		honestThreshold     int
+		rules               interception.Rules
+		expectedMisbehaving []group.MemberIndex
+	}{
+		"member inactive in phase 1": {
+			groupSize:           5,
+			honestThreshold:     3,
+			rules:               gjkr.DropMessages(1, "gjkr/ephemeral_public_key"),
+			expectedMisbehaving: []group.MemberIndex{1},
+		},
+		"member inactive in phase 8": {
+			groupSize:           5,
+			honestThreshold:     3,
+			rules:               gjkr.DropMessages(3, "gjkr/points_accusations_message"),
+			expectedMisbehaving: []group.MemberIndex{3},
+		},
+		"member sending shares which can not be decrypted": {
+			groupSize:           5,
+			honestThreshold:     3,
+			rules:               gjkr.CorruptShares(2, 1),
+			expectedMisbehaving: []group.MemberIndex{2},
+		},
+		"member publishing inconsistent commitment": {
+			groupSize:           5,
+			honestThreshold:     3,
+			rules:               gjkr.CorruptCommitment(5, 2),
+			expectedMisbehaving: []group.MemberIndex{5},
+		},
+		"member publishing inconsistent public key share point": {
+			groupSize:           5,
+			honestThreshold:     3,
+			rules:               gjkr.CorruptPublicKeySharePoint(4, 1),
+			expectedMisbehaving: []group.MemberIndex{4},
+		},
+		"members misbehaving in different phases": {
+			groupSize:       7,
+			honestThreshold: 4,
+			rules: gjkr.Misbehave(
+				gjkr.CorruptShares(2, 6),
+				gjkr.DropMessages(5, "gjkr/member_commitments"),
+			),
+			expectedMisbehaving: []group.MemberIndex{2, 5},
+		},
+	}
+
+	for testName, test := range tests {
+		test := test
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			seed := dkgtest.RandomSeed(t)
+
+			result, err := dkgtest.RunTest(
+				test.groupSize,
+				test.honestThreshold,
+				seed,
+				test.rules,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var expectedSigners []group.MemberIndex
+			for i := 1; i <= test.groupSize; i++ {
+				memberID := group.MemberIndex(i)
+				misbehaving := false
+				for _, misbehavingID := range test.expectedMisbehaving {
+					if memberID == misbehavingID {
+						misbehaving = true
+					}
+				}
+				if !misbehaving {
+					expectedSigners = append(expectedSigners, memberID)
+				}
+			}
+
+			dkgtest.AssertDkgResultPublished(t, result)
+			dkgtest.AssertSuccessfulSignersCount(t, result, len(expectedSigners))
+			dkgtest.AssertSuccessfulSigners(t, result, expectedSigners...)
+			dkgtest.AssertMemberFailuresCount(t, result, len(test.expectedMisbehaving))
+			dkgtest.AssertSamePublicKey(t, result)
+			dkgtest.AssertMisbehavingMembers(t, result, test.expectedMisbehaving...)
+			dkgtest.AssertValidGroupPublicKey(t, result)
+			dkgtest.AssertResultSupportingMembers(t, result, expectedSigners...)
+		})
+	}
+}
+
 // manInTheMiddle is a helper tool allowing to easily intercept communication
 // of a chosen member with the rest of the members for all phases of DKG.
 // Man in the middle sets up symmetric keys, member shares, and commitments
@@ -1242,6 +1409,23 @@ type manInTheMiddle struct {
 
 	// phase 7
 	publicKeySharePoints []*bn256.G2
+
+	// Operator key shared by all members of the group, used to sign
+	// accusations altered on behalf of the sender.
+	operatorKey *operator.PrivateKey
+	signing     chain.Signing
+}
+
+// newOperatorSigning generates an operator key to be shared by all members
+// of the test group and a signer letting interception rules sign accusations
+// altered on behalf of an accuser.
+func newOperatorSigning(t *testing.T) (*operator.PrivateKey, chain.Signing) {
+	operatorKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return operatorKey, commonLocal.NewSigner(operatorKey)
 }
 
 // newManInTheMiddle creates a new instance of manInTheMiddle tool.
@@ -1268,7 +1452,7 @@ func newManInTheMiddle(
 		// ephemeral key pair - we'll create symmetric key between
 		// sender and receiver using this key pair in phase 1 and 2
 		// of the protocol
-		keyPair, err := ephemeral.GenerateKeyPair()
+		keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
 		if err != nil {
 			return nil, err
 		}
@@ -1304,6 +1488,13 @@ func newManInTheMiddle(
 		publicKeySharePoints[i] = new(bn256.G2).ScalarBaseMult(a)
 	}
 
+	// operator key shared by all members of the group lets man-in-the-middle
+	// sign altered accusations as if they were signed by the original sender
+	operatorKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
 	return &manInTheMiddle{
 		senderIndex: senderIndex,
 		seed:        seed,
@@ -1318,6 +1509,9 @@ func newManInTheMiddle(
 		commitments: commitments,
 
 		publicKeySharePoints: publicKeySharePoints,
+
+		operatorKey: operatorKey,
+		signing:     commonLocal.NewSigner(operatorKey),
 	}, nil
 }
 
@@ -1410,12 +1604,14 @@ func (mitm *manInTheMiddle) interceptCommunication(
 	if ok && secretSharesAccusationsMessage.SenderID() == mitm.senderIndex {
 		accusedMembersKeys := make(map[group.MemberIndex]*ephemeral.PrivateKey)
 		secretSharesAccusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+		_ = secretSharesAccusationsMessage.Sign(mitm.signing, mitm.seed)
 		return secretSharesAccusationsMessage
 	}
 	pointsAccusationsMessage, ok := msg.(*gjkr.PointsAccusationsMessage)
 	if ok && pointsAccusationsMessage.SenderID() == mitm.senderIndex {
 		accusedMembersKeys := make(map[group.MemberIndex]*ephemeral.PrivateKey)
 		pointsAccusationsMessage.SetAccusedMemberKeys(accusedMembersKeys)
+		_ = pointsAccusationsMessage.Sign(mitm.signing, mitm.seed)
 		return pointsAccusationsMessage
 	}
 	misbehavedKeysMessage, ok := msg.(*gjkr.MisbehavedEphemeralKeysMessage)
@@ -9,18 +9,26 @@ import (
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
 
-// MemberIndex is represented as uint8 in gjkr. Protobuf does not have uint8
-// type so we are using uint32. When unmarshalling message, we need to make
-// sure we do not overflow.
-const maxMemberIndex = 255
-
+// validateMemberIndex makes sure the unmarshalled member index does not
+// overflow. MemberIndex is represented as uint8 in gjkr. Protobuf does not
+// have uint8 type so we are using uint32.
 func validateMemberIndex(protoIndex uint32) error {
-	if protoIndex > maxMemberIndex {
+	if protoIndex > group.MaxMemberIndex {
 		return fmt.Errorf("Invalid member index value: [%v]", protoIndex)
 	}
 	return nil
 }
 
+// validateSenderIndex makes sure the sender index is present in the message
+// and does not overflow. Member indexes start from 1 so zero means the field
+// was not set by the sender.
+func validateSenderIndex(protoIndex uint32) error {
+	if protoIndex == 0 {
+		return fmt.Errorf("missing sender index")
+	}
+	return validateMemberIndex(protoIndex)
+}
+
 // Type returns a string describing an EphemeralPublicKeyMessage type for
 // marshaling purposes.
 func (epkm *EphemeralPublicKeyMessage) Type() string {
@@ -49,7 +57,7 @@ func (epkm *EphemeralPublicKeyMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	epkm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -92,11 +100,15 @@ func (mcm *MemberCommitmentsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	mcm.senderID = group.MemberIndex(pbMsg.SenderID)
 
+	if len(pbMsg.Commitments) == 0 {
+		return fmt.Errorf("missing member's commitments")
+	}
+
 	var commitments []*bn256.G1
 	for _, commitmentBytes := range pbMsg.Commitments {
 		commitment := new(bn256.G1)
@@ -148,7 +160,7 @@ func (psm *PeerSharesMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	psm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -163,6 +175,11 @@ func (psm *PeerSharesMessage) Unmarshal(bytes []byte) error {
 			return fmt.Errorf("nil shares from member [%v]", memberID)
 		}
 
+		if len(pbShares.EncryptedShareS) == 0 ||
+			len(pbShares.EncryptedShareT) == 0 {
+			return fmt.Errorf("missing shares for member [%v]", memberID)
+		}
+
 		shares[group.MemberIndex(memberID)] = &peerShares{
 			encryptedShareS: pbShares.EncryptedShareS,
 			encryptedShareT: pbShares.EncryptedShareT,
@@ -188,10 +205,16 @@ func (ssam *SecretSharesAccusationsMessage) Marshal() ([]byte, error) {
 		return nil, err
 	}
 
-	return (&pb.SecretSharesAccusations{
+	pbMsg := &pb.SecretSharesAccusations{
 		SenderID:           uint32(ssam.senderID),
 		AccusedMembersKeys: accusedMembersKeys,
-	}).Marshal()
+	}
+	if ssam.authentication != nil {
+		pbMsg.Signature = ssam.authentication.signature
+		pbMsg.PublicKey = ssam.authentication.publicKey
+	}
+
+	return pbMsg.Marshal()
 }
 
 // Unmarshal converts a byte array produced by Marshal to
@@ -202,17 +225,21 @@ func (ssam *SecretSharesAccusationsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	ssam.senderID = group.MemberIndex(pbMsg.SenderID)
 
 	accusedMembersKeys, err := unmarshalPrivateKeyMap(pbMsg.AccusedMembersKeys)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	ssam.accusedMembersKeys = accusedMembersKeys
+	ssam.authentication = unmarshalMessageAuthentication(
+		pbMsg.Signature,
+		pbMsg.PublicKey,
+	)
 
 	return nil
 }
@@ -249,11 +276,15 @@ func (mpspm *MemberPublicKeySharePointsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	mpspm.senderID = group.MemberIndex(pbMsg.SenderID)
 
+	if len(pbMsg.PublicKeySharePoints) == 0 {
+		return fmt.Errorf("missing member's key share points")
+	}
+
 	var keySharePoints []*bn256.G2
 	for _, keySharePointBytes := range pbMsg.PublicKeySharePoints {
 		keySharePoint := new(bn256.G2)
@@ -285,10 +316,16 @@ func (pam *PointsAccusationsMessage) Marshal() ([]byte, error) {
 		return nil, err
 	}
 
-	return (&pb.PointsAccusations{
+	pbMsg := &pb.PointsAccusations{
 		SenderID:           uint32(pam.senderID),
 		AccusedMembersKeys: accusedMembersKeys,
-	}).Marshal()
+	}
+	if pam.authentication != nil {
+		pbMsg.Signature = pam.authentication.signature
+		pbMsg.PublicKey = pam.authentication.publicKey
+	}
+
+	return pbMsg.Marshal()
 }
 
 // Unmarshal converts a byte array produced by Marshal to
@@ -299,17 +336,21 @@ func (pam *PointsAccusationsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	pam.senderID = group.MemberIndex(pbMsg.SenderID)
 
 	accusedMembersKeys, err := unmarshalPrivateKeyMap(pbMsg.AccusedMembersKeys)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	pam.accusedMembersKeys = accusedMembersKeys
+	pam.authentication = unmarshalMessageAuthentication(
+		pbMsg.Signature,
+		pbMsg.PublicKey,
+	)
 
 	return nil
 }
@@ -342,7 +383,7 @@ func (mekm *MisbehavedEphemeralKeysMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 
-	if err := validateMemberIndex(pbMsg.SenderID); err != nil {
+	if err := validateSenderIndex(pbMsg.SenderID); err != nil {
 		return err
 	}
 	mekm.senderID = group.MemberIndex(pbMsg.SenderID)
@@ -392,6 +433,22 @@ func unmarshalPublicKeyMap(
 	return unmarshalled, nil
 }
 
+// unmarshalMessageAuthentication returns nil if neither the signature nor
+// the public key of the sender has been sent along with the message.
+func unmarshalMessageAuthentication(
+	signature []byte,
+	publicKey []byte,
+) *messageAuthentication {
+	if len(signature) == 0 && len(publicKey) == 0 {
+		return nil
+	}
+
+	return &messageAuthentication{
+		signature: signature,
+		publicKey: publicKey,
+	}
+}
+
 func marshalPrivateKeyMap(
 	privateKeys map[group.MemberIndex]*ephemeral.PrivateKey,
 ) (map[uint32][]byte, error) {
@@ -1,6 +1,9 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -8,18 +11,19 @@ import (
 	fuzz "github.com/google/gofuzz"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr/gen/pb"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/internal/pbutils"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
 
 func TestEphemeralPublicKeyMessageRoundtrip(t *testing.T) {
-	keyPair1, err := ephemeral.GenerateKeyPair()
+	keyPair1, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	keyPair2, err := ephemeral.GenerateKeyPair()
+	keyPair2, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -119,6 +123,76 @@ func TestFuzzMemberCommitmentsMessageUnmarshaler(t *testing.T) {
 	pbutils.FuzzUnmarshaler(&MemberCommitmentsMessage{})
 }
 
+func TestFuzzMemberCommitmentsMessageUnmarshalerWithSeeds(t *testing.T) {
+	seed, err := (&MemberCommitmentsMessage{
+		senderID: group.MemberIndex(11),
+		commitments: []*bn256.G1{
+			new(bn256.G1).ScalarBaseMult(big.NewInt(1231)),
+			new(bn256.G1).ScalarBaseMult(big.NewInt(879)),
+		},
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pbutils.FuzzUnmarshalerWithSeeds(&MemberCommitmentsMessage{}, seed)
+}
+
+func TestMemberCommitmentsMessageUnmarshalMalformed(t *testing.T) {
+	validCommitment := new(bn256.G1).ScalarBaseMult(big.NewInt(1231)).Marshal()
+
+	invalidCommitment := make([]byte, len(validCommitment))
+	copy(invalidCommitment, validCommitment)
+	invalidCommitment[len(invalidCommitment)-1] ^= 0x01
+
+	var tests = map[string]struct {
+		message *pb.MemberCommitments
+	}{
+		"sender index overflow": {
+			message: &pb.MemberCommitments{
+				SenderID:    256,
+				Commitments: [][]byte{validCommitment},
+			},
+		},
+		"missing sender index": {
+			message: &pb.MemberCommitments{
+				Commitments: [][]byte{validCommitment},
+			},
+		},
+		"missing commitments": {
+			message: &pb.MemberCommitments{
+				SenderID: 1,
+			},
+		},
+		"commitment not on curve": {
+			message: &pb.MemberCommitments{
+				SenderID:    1,
+				Commitments: [][]byte{validCommitment, invalidCommitment},
+			},
+		},
+		"truncated commitment": {
+			message: &pb.MemberCommitments{
+				SenderID:    1,
+				Commitments: [][]byte{validCommitment[:10]},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := test.message.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = (&MemberCommitmentsMessage{}).Unmarshal(bytes)
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
 func TestPeerSharesMessageRoundtrip(t *testing.T) {
 	shares := make(map[group.MemberIndex]*peerShares)
 	shares[group.MemberIndex(112)] = &peerShares{
@@ -187,13 +261,103 @@ func TestFuzzPeerSharesMessageUnmarshaler(t *testing.T) {
 	pbutils.FuzzUnmarshaler(&PeerSharesMessage{})
 }
 
+func TestFuzzPeerSharesMessageUnmarshalerWithSeeds(t *testing.T) {
+	shares := make(map[group.MemberIndex]*peerShares)
+	shares[group.MemberIndex(1)] = &peerShares{
+		encryptedShareS: []byte{0x01, 0x02, 0x03, 0x04, 0x05},
+		encryptedShareT: []byte{0x0F, 0x0E, 0x0D, 0x0C, 0x0B},
+	}
+	shares[group.MemberIndex(3)] = &peerShares{
+		encryptedShareS: []byte{0x0A, 0x0E, 0x0F, 0x0F, 0x0F},
+		encryptedShareT: []byte{0x01, 0x0F, 0x0E, 0x0E, 0x0D},
+	}
+
+	seed, err := (&PeerSharesMessage{
+		senderID: group.MemberIndex(2),
+		shares:   shares,
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pbutils.FuzzUnmarshalerWithSeeds(&PeerSharesMessage{}, seed)
+}
+
+func TestPeerSharesMessageUnmarshalMalformed(t *testing.T) {
+	validShares := &pb.PeerShares_Shares{
+		EncryptedShareS: []byte{0x01, 0x02, 0x03},
+		EncryptedShareT: []byte{0x0F, 0x0E, 0x0D},
+	}
+
+	var tests = map[string]struct {
+		bytes func() ([]byte, error)
+	}{
+		"sender index overflow": {
+			bytes: (&pb.PeerShares{
+				SenderID: 256,
+				Shares:   map[uint32]*pb.PeerShares_Shares{1: validShares},
+			}).Marshal,
+		},
+		"receiver index overflow": {
+			bytes: (&pb.PeerShares{
+				SenderID: 1,
+				Shares:   map[uint32]*pb.PeerShares_Shares{256: validShares},
+			}).Marshal,
+		},
+		"missing sender index": {
+			bytes: (&pb.PeerShares{
+				Shares: map[uint32]*pb.PeerShares_Shares{1: validShares},
+			}).Marshal,
+		},
+		"missing encrypted share": {
+			bytes: (&pb.PeerShares{
+				SenderID: 1,
+				Shares: map[uint32]*pb.PeerShares_Shares{
+					2: {EncryptedShareS: []byte{0x01, 0x02, 0x03}},
+				},
+			}).Marshal,
+		},
+		"truncated message": {
+			bytes: func() ([]byte, error) {
+				bytes, err := (&pb.PeerShares{
+					SenderID: 1,
+					Shares:   map[uint32]*pb.PeerShares_Shares{2: validShares},
+				}).Marshal()
+				if err != nil {
+					return nil, err
+				}
+				return bytes[:len(bytes)-2], nil
+			},
+		},
+		"invalid wire type": {
+			bytes: func() ([]byte, error) {
+				return []byte{0x0F, 0xFF, 0xFF, 0xFF}, nil
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := test.bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = (&PeerSharesMessage{}).Unmarshal(bytes)
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
 func TestSecretSharesAccusationsMessageRoundtrip(t *testing.T) {
-	keyPair1, err := ephemeral.GenerateKeyPair()
+	keyPair1, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	keyPair2, err := ephemeral.GenerateKeyPair()
+	keyPair2, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -204,6 +368,10 @@ func TestSecretSharesAccusationsMessageRoundtrip(t *testing.T) {
 			group.MemberIndex(12): keyPair1.PrivateKey,
 			group.MemberIndex(92): keyPair2.PrivateKey,
 		},
+		authentication: &messageAuthentication{
+			signature: []byte{0x01, 0x02, 0x03},
+			publicKey: []byte{0x04, 0x05, 0x06},
+		},
 	}
 	unmarshaled := &SecretSharesAccusationsMessage{}
 
@@ -244,6 +412,44 @@ func TestFuzzSecretSharesAccusationsMessageUnmarshaler(t *testing.T) {
 	pbutils.FuzzUnmarshaler(&SecretSharesAccusationsMessage{})
 }
 
+func TestSecretSharesAccusationsMessageUnmarshalMalformed(t *testing.T) {
+	var tests = map[string]struct {
+		message *pb.SecretSharesAccusations
+	}{
+		"missing sender index": {
+			message: &pb.SecretSharesAccusations{
+				AccusedMembersKeys: map[uint32][]byte{2: {0x01}},
+			},
+		},
+		"accused index overflow": {
+			message: &pb.SecretSharesAccusations{
+				SenderID:           1,
+				AccusedMembersKeys: map[uint32][]byte{256: {0x01}},
+			},
+		},
+		"missing private key": {
+			message: &pb.SecretSharesAccusations{
+				SenderID:           1,
+				AccusedMembersKeys: map[uint32][]byte{2: {}},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := test.message.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = (&SecretSharesAccusationsMessage{}).Unmarshal(bytes)
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
 func TestMemberPublicKeySharePointsMessageRoundtrip(t *testing.T) {
 	msg := &MemberPublicKeySharePointsMessage{
 		senderID: group.MemberIndex(98),
@@ -293,13 +499,52 @@ func TestFuzzMemberPublicKeySharePointsMessageUnmarshaler(t *testing.T) {
 	pbutils.FuzzUnmarshaler(&MemberPublicKeySharePointsMessage{})
 }
 
+func TestMemberPublicKeySharePointsMessageUnmarshalMalformed(t *testing.T) {
+	validPoint := new(bn256.G2).ScalarBaseMult(big.NewInt(1231)).Marshal()
+
+	var tests = map[string]struct {
+		message *pb.MemberPublicKeySharePoints
+	}{
+		"missing sender index": {
+			message: &pb.MemberPublicKeySharePoints{
+				PublicKeySharePoints: [][]byte{validPoint},
+			},
+		},
+		"missing key share points": {
+			message: &pb.MemberPublicKeySharePoints{
+				SenderID: 1,
+			},
+		},
+		"truncated key share point": {
+			message: &pb.MemberPublicKeySharePoints{
+				SenderID:             1,
+				PublicKeySharePoints: [][]byte{validPoint[:10]},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := test.message.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = (&MemberPublicKeySharePointsMessage{}).Unmarshal(bytes)
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
 func TestPointsAccusationsMessageRoundtrip(t *testing.T) {
-	keyPair1, err := ephemeral.GenerateKeyPair()
+	keyPair1, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	keyPair2, err := ephemeral.GenerateKeyPair()
+	keyPair2, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -310,6 +555,10 @@ func TestPointsAccusationsMessageRoundtrip(t *testing.T) {
 			group.MemberIndex(41): keyPair1.PrivateKey,
 			group.MemberIndex(11): keyPair2.PrivateKey,
 		},
+		authentication: &messageAuthentication{
+			signature: []byte{0x01, 0x02, 0x03},
+			publicKey: []byte{0x04, 0x05, 0x06},
+		},
 	}
 	unmarshaled := &PointsAccusationsMessage{}
 
@@ -351,12 +600,12 @@ func TestFuzzPointsAccusationsMessageUnmarshaler(t *testing.T) {
 }
 
 func TestMisbehavedEphemeralKeysMessageRoundtrip(t *testing.T) {
-	keyPair1, err := ephemeral.GenerateKeyPair()
+	keyPair1, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	keyPair2, err := ephemeral.GenerateKeyPair()
+	keyPair2, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -406,3 +655,117 @@ func TestFuzzMisbehavedEphemeralKeysMessageRoundtrip(t *testing.T) {
 func TestFuzzMisbehavedEphemeralKeysMessageUnmarshaler(t *testing.T) {
 	pbutils.FuzzUnmarshaler(&MisbehavedEphemeralKeysMessage{})
 }
+
+// BenchmarkMessageSize compares the size of protobuf encoded messages with
+// the size of the same messages encoded as JSON, for a group of 64 members.
+func BenchmarkMessageSize(b *testing.B) {
+	groupSize := 64
+	dishonestThreshold := 31
+
+	ephemeralPublicKeys := make(map[group.MemberIndex]*ephemeral.PublicKey)
+	shares := make(map[group.MemberIndex]*peerShares)
+	for i := 2; i <= groupSize; i++ {
+		keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
+		if err != nil {
+			b.Fatal(err)
+		}
+		ephemeralPublicKeys[group.MemberIndex(i)] = keyPair.PublicKey
+
+		shares[group.MemberIndex(i)] = &peerShares{
+			encryptedShareS: make([]byte, 60),
+			encryptedShareT: make([]byte, 60),
+		}
+	}
+
+	var commitments []*bn256.G1
+	var keySharePoints []*bn256.G2
+	for i := 0; i <= dishonestThreshold; i++ {
+		commitments = append(
+			commitments,
+			new(bn256.G1).ScalarBaseMult(big.NewInt(int64(i+1))),
+		)
+		keySharePoints = append(
+			keySharePoints,
+			new(bn256.G2).ScalarBaseMult(big.NewInt(int64(i+1))),
+		)
+	}
+
+	messages := map[string]interface {
+		Marshal() ([]byte, error)
+	}{
+		"ephemeral_public_key": &EphemeralPublicKeyMessage{
+			senderID:            1,
+			ephemeralPublicKeys: ephemeralPublicKeys,
+		},
+		"member_commitments": &MemberCommitmentsMessage{
+			senderID:    1,
+			commitments: commitments,
+		},
+		"peer_shares": &PeerSharesMessage{
+			senderID: 1,
+			shares:   shares,
+		},
+		"member_public_key_share_points": &MemberPublicKeySharePointsMessage{
+			senderID:             1,
+			publicKeySharePoints: keySharePoints,
+		},
+	}
+
+	for name, message := range messages {
+		message := message
+
+		b.Run(name+"/protobuf", func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				bytes, err := message.Marshal()
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(bytes)
+			}
+			b.ReportMetric(float64(size), "bytes/msg")
+		})
+
+		b.Run(name+"/json", func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				bytes, err := marshalJSON(message)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(bytes)
+			}
+			b.ReportMetric(float64(size), "bytes/msg")
+		})
+	}
+}
+
+// marshalJSON encodes the protobuf representation of the message as JSON.
+// Byte fields, including marshalled curve points, are encoded as base64
+// strings which is how JSON represents binary data.
+func marshalJSON(message interface{ Marshal() ([]byte, error) }) ([]byte, error) {
+	bytes, err := message.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var pbMsg interface{ Unmarshal([]byte) error }
+	switch message.(type) {
+	case *EphemeralPublicKeyMessage:
+		pbMsg = &pb.EphemeralPublicKey{}
+	case *MemberCommitmentsMessage:
+		pbMsg = &pb.MemberCommitments{}
+	case *PeerSharesMessage:
+		pbMsg = &pb.PeerShares{}
+	case *MemberPublicKeySharePointsMessage:
+		pbMsg = &pb.MemberPublicKeySharePoints{}
+	default:
+		return nil, fmt.Errorf("unsupported message type [%T]", message)
+	}
+
+	if err := pbMsg.Unmarshal(bytes); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(pbMsg)
+}
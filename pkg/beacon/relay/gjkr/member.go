@@ -1,10 +1,13 @@
 package gjkr
 
 import (
+	"fmt"
+	"io"
 	"math/big"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
 
@@ -25,6 +28,41 @@ type memberCore struct {
 
 	// Cryptographic protocol parameters, the same for all members in the group.
 	protocolParameters *protocolParameters
+
+	// Source of randomness used to generate ephemeral key pairs. It should
+	// always be `crypto/rand.Reader` except for tests replaying a protocol
+	// execution from a fixed seed.
+	randomSource io.Reader
+
+	// Callback notified each time the member completes a protocol phase.
+	// Progress is not reported if the callback is nil.
+	progressCallback ProgressCallback
+
+	// Maximum number of members accused in phase 4 after which verification
+	// of the remaining shares is stopped. Zero means all shares are verified.
+	maxSharesAccusations int
+
+	// Degree of polynomials generated by each member in phase 3. Zero means
+	// the degree is equal to the dishonest threshold.
+	customPolynomialDegree int
+
+	// Maximum number of distinct members which may be accused in phase 4 or
+	// phase 8 before the protocol is aborted.
+	maxAccusations int
+
+	// Records of members disqualified by this member, in the order in which
+	// they were disqualified.
+	disqualificationRecords []DisqualificationRecord
+
+	// Signer used to sign accusations published by the member and to verify
+	// accusations received from other members. Accusations are neither
+	// signed nor verified if the signer is nil.
+	signing chain.Signing
+
+	// Store persisting ephemeral private keys generated in phase 1 in the
+	// given directory. Keys are kept in memory only if the store is nil.
+	ephemeralKeyStore          *EphemeralKeyStore
+	ephemeralKeyStoreDirectory string
 }
 
 // LocalMember represents one member in a threshold group, prior to the
@@ -95,9 +133,11 @@ type CommitmentsVerifyingMember struct {
 	// receivedQualifiedSharesS are defined as `s_ji` and receivedQualifiedSharesT are
 	// defined as `t_ji` across the protocol specification.
 	// TODO remove receivedQualifiedSharesT - exists only for unit tests purpose
+	// receivedQualifiedSharesT are released once shares are combined in phase 6.
 	receivedQualifiedSharesS, receivedQualifiedSharesT map[group.MemberIndex]*big.Int
 	// Commitments to secret shares polynomial coefficients received from
-	// other group members.
+	// other group members. Commitments of members outside of QUAL are released
+	// in phase 6, all the remaining ones after reconstruction in phase 11.
 	receivedPeerCommitments map[group.MemberIndex][]*bn256.G1
 }
 
@@ -138,6 +178,10 @@ type SharingMember struct {
 	// Public key share points received from other group members which passed
 	// the validation. Defined as `A_jk` across the protocol documentation.
 	receivedValidPeerPublicKeySharePoints map[group.MemberIndex][]*bn256.G2
+	// Individual public keys `A_j0` received from other group members in
+	// phase 7, including those whose public key share points did not pass
+	// the validation.
+	receivedPeerIndividualPublicKeys map[group.MemberIndex]*bn256.G2
 }
 
 // PointsJustifyingMember represents one member in a threshold key sharing group,
@@ -192,6 +236,9 @@ type ReconstructingMember struct {
 	// - `m` is disqualified member's ID
 	// - `y_m` is reconstructed individual public key of member `m`
 	reconstructedIndividualPublicKeys map[group.MemberIndex]*bn256.G2
+	// Errors of reconstructed individual public keys not matching individual
+	// public keys `A_m0` misbehaved members broadcast in phase 7.
+	reconstructionErrors []error
 }
 
 // CombiningMember represents one member in a threshold sharing group who is
@@ -223,25 +270,286 @@ type FinalizingMember struct {
 	*CombiningMember
 }
 
-// NewMember creates a new member in an initial state
+// NewMember creates a new member in an initial state. The provided random
+// source is used to generate member's ephemeral key pairs. The provided
+// progress callback is notified each time the member completes a protocol
+// phase; if it is nil, progress is not reported.
 func NewMember(
 	memberID group.MemberIndex,
 	groupSize,
 	dishonestThreshold int,
 	membershipValidator group.MembershipValidator,
 	seed *big.Int,
+	randomSource io.Reader,
+	progressCallback ProgressCallback,
 ) (*LocalMember, error) {
+	if groupSize < 1 || groupSize > group.MaxMemberIndex {
+		return nil, fmt.Errorf(
+			"%w: group size [%v] must be in range [1, %v]",
+			ErrInvalidConfig,
+			groupSize,
+			group.MaxMemberIndex,
+		)
+	}
+	if dishonestThreshold < 0 || dishonestThreshold >= groupSize {
+		return nil, fmt.Errorf(
+			"%w: dishonest threshold [%v] must be in range [0, %v)",
+			ErrInvalidConfig,
+			dishonestThreshold,
+			groupSize,
+		)
+	}
+	if memberID < 1 || int(memberID) > groupSize {
+		return nil, fmt.Errorf(
+			"%w: member index [%v] must be in range [1, %v]",
+			ErrInvalidConfig,
+			memberID,
+			groupSize,
+		)
+	}
+	if seed == nil {
+		return nil, fmt.Errorf("%w: seed is nil", ErrInvalidConfig)
+	}
+	if randomSource == nil {
+		return nil, fmt.Errorf("%w: random source is nil", ErrInvalidConfig)
+	}
+
 	return &LocalMember{
 		memberCore: &memberCore{
-			memberID,
-			group.NewDkgGroup(dishonestThreshold, groupSize),
-			membershipValidator,
-			newDkgEvidenceLog(),
-			newProtocolParameters(seed),
+			ID:                  memberID,
+			group:               group.NewDkgGroup(dishonestThreshold, groupSize),
+			membershipValidator: membershipValidator,
+			evidenceLog:         newDkgEvidenceLog(),
+			protocolParameters:  newProtocolParameters(seed),
+			randomSource:        randomSource,
+			progressCallback:    progressCallback,
+			maxAccusations:      dishonestThreshold,
 		},
 	}, nil
 }
 
+// LimitSharesAccusations sets the maximum number of members the member may
+// accuse in phase 4 before it stops verifying shares received from the
+// remaining members. The protocol can not produce a group once more than
+// dishonest threshold members are disqualified, so verification of further
+// shares can be skipped for large groups. The limit has to be greater than
+// the dishonest threshold. Zero, the default, disables the limit and all
+// received shares are verified.
+//
+// Unlike SetMaxAccusations, the limit applies only to accusations published
+// by the member itself and it never aborts the protocol. It only lets the
+// member save time on verification of shares once it is clear the group can
+// not be created.
+func (lm *LocalMember) LimitSharesAccusations(maxAccusations int) error {
+	if maxAccusations != 0 &&
+		maxAccusations <= lm.group.DishonestThreshold() {
+		return fmt.Errorf(
+			"%w: maximum accusations [%v] must be zero or greater "+
+				"than dishonest threshold [%v]",
+			ErrInvalidConfig,
+			maxAccusations,
+			lm.group.DishonestThreshold(),
+		)
+	}
+
+	lm.maxSharesAccusations = maxAccusations
+	return nil
+}
+
+// SetPolynomialDegree sets the degree of polynomials generated by the member
+// in phase 3. By default, the degree is equal to the dishonest threshold.
+// A higher degree lets to experiment with robustness of the protocol, though
+// degree + 1 shares are then required to reconstruct a secret and to produce
+// a group signature. The degree can not be lower than the dishonest threshold
+// and it has to let honest members reconstruct secrets on their own, so it
+// must be lower than group size minus the dishonest threshold.
+//
+// All members of the group have to use the same degree; messages with
+// a different number of commitments or public key share points are rejected.
+func (lm *LocalMember) SetPolynomialDegree(degree int) error {
+	dishonestThreshold := lm.group.DishonestThreshold()
+	maxDegree := lm.group.GroupSize() - dishonestThreshold - 1
+	if degree < dishonestThreshold || degree > maxDegree {
+		return fmt.Errorf(
+			"%w: polynomial degree [%v] must be in range [%v, %v]",
+			ErrInvalidConfig,
+			degree,
+			dishonestThreshold,
+			maxDegree,
+		)
+	}
+
+	lm.customPolynomialDegree = degree
+	return nil
+}
+
+// UsePrecomputedGenerators makes the member precompute tables of multiples of
+// generators `G` and `H` used to calculate Pedersen commitments. Phase 3
+// commitments are then calculated with point additions only at the cost of
+// about 200 KB of memory held for the generators. Members executing the
+// protocol in the same process with the same seed share the precomputed
+// tables. By default, tables are not precomputed.
+func (lm *LocalMember) UsePrecomputedGenerators() {
+	lm.protocolParameters = newPrecomputedProtocolParameters(
+		lm.protocolParameters.seed,
+	)
+}
+
+// SetMaxAccusations sets the maximum number of distinct members which may be
+// accused by other members in phase 4 or in phase 8 of the protocol. If more
+// members are accused, the group is considered compromised and the protocol
+// is aborted with ErrTooManyAccusations instead of resolving the accusations.
+// The limit has to be in range [1, group size].
+//
+// By default, the limit is equal to the dishonest threshold. Note that
+// a single malicious member can accuse any number of honest members, so it
+// can abort the protocol instead of being disqualified for false accusations
+// in phase 5 or phase 9. Setting the limit to the group size lets all
+// accusations to be resolved.
+//
+// Unlike LimitSharesAccusations, the limit applies to accusations published
+// by other members and it is checked before the accusations are resolved.
+func (lm *LocalMember) SetMaxAccusations(maxAccusations int) error {
+	if maxAccusations < 1 || maxAccusations > lm.group.GroupSize() {
+		return fmt.Errorf(
+			"%w: maximum accusations [%v] must be in range [1, %v]",
+			ErrInvalidConfig,
+			maxAccusations,
+			lm.group.GroupSize(),
+		)
+	}
+
+	lm.maxAccusations = maxAccusations
+	return nil
+}
+
+// SetSigning sets the signer used by the member to sign accusations it
+// publishes in phases 4 and 8 and to verify accusations published by other
+// members. Once the signer is set, unsigned, forged and replayed accusations
+// are rejected in phases 5 and 9. By default, the signer is not set and
+// accusations are neither signed nor verified.
+func (lm *LocalMember) SetSigning(signing chain.Signing) error {
+	if signing == nil {
+		return fmt.Errorf("%w: signing is nil", ErrInvalidConfig)
+	}
+
+	lm.signing = signing
+	return nil
+}
+
+// SetEphemeralKeyStore sets the store in which ephemeral private keys
+// generated in phase 1 are persisted, in the given directory which has to be
+// unique for the key generation. If the member is restarted before phase 2,
+// the keys are loaded from the store to generate symmetric keys. By default,
+// ephemeral private keys are kept in memory only.
+func (lm *LocalMember) SetEphemeralKeyStore(
+	store *EphemeralKeyStore,
+	directory string,
+) error {
+	if store == nil || directory == "" {
+		return fmt.Errorf(
+			"%w: ephemeral key store and directory must be set",
+			ErrInvalidConfig,
+		)
+	}
+
+	lm.ephemeralKeyStore = store
+	lm.ephemeralKeyStoreDirectory = directory
+	return nil
+}
+
+// checkAccusations returns an error if accusations published by other members
+// in the given phase are against more distinct members than the accusations
+// limit allows. Self-accusations and accusations of members with invalid IDs
+// do not count towards the limit since they are never resolved against the
+// accused member.
+func (mc *memberCore) checkAccusations(
+	phase int,
+	accusations map[group.MemberIndex]map[group.MemberIndex]*ephemeral.PrivateKey,
+) error {
+	accusedMembers := make(map[group.MemberIndex]bool)
+	for accuserID, accusedMembersKeys := range accusations {
+		for accusedID := range accusedMembersKeys {
+			isAccusedIDValid := accusedID > 0 &&
+				int(accusedID) <= mc.group.GroupSize()
+			if accusedID != accuserID && isAccusedIDValid {
+				accusedMembers[accusedID] = true
+			}
+		}
+	}
+
+	if len(accusedMembers) > mc.maxAccusations {
+		return fmt.Errorf(
+			"%w: [%v] members accused in phase [%v]; at most [%v] are allowed",
+			ErrTooManyAccusations,
+			len(accusedMembers),
+			phase,
+			mc.maxAccusations,
+		)
+	}
+
+	return nil
+}
+
+// disqualify marks the given member as disqualified in the given phase of
+// the protocol and records the reason of disqualification. If the member is
+// not operating anymore, nothing is recorded, just like the member is not
+// marked as disqualified again.
+func (mc *memberCore) disqualify(
+	memberID group.MemberIndex,
+	phase int,
+	reason string,
+) {
+	if !mc.group.IsOperating(memberID) {
+		return
+	}
+
+	mc.group.MarkMemberAsDisqualified(memberID)
+	mc.disqualificationRecords = append(
+		mc.disqualificationRecords,
+		DisqualificationRecord{
+			MemberID: memberID,
+			Phase:    phase,
+			Reason:   reason,
+		},
+	)
+}
+
+// disqualifications returns records of all members disqualified by
+// this member so far, in the order in which they were disqualified.
+func (mc *memberCore) disqualifications() []DisqualificationRecord {
+	return append([]DisqualificationRecord{}, mc.disqualificationRecords...)
+}
+
+// checkOperatingMembers returns an error if the number of members still
+// operating in the group, that is neither disqualified nor inactive, is lower
+// than the number of shares required to reconstruct a secret. The protocol
+// can not produce a usable group in such case, so it should be aborted.
+func (mc *memberCore) checkOperatingMembers() error {
+	operatingMembersCount := len(mc.group.OperatingMemberIDs())
+	requiredMembersCount := mc.polynomialDegree() + 1
+
+	if operatingMembersCount < requiredMembersCount {
+		return fmt.Errorf(
+			"%w: [%v] members operating; [%v] are required",
+			ErrInsufficientOperatingMembers,
+			operatingMembersCount,
+			requiredMembersCount,
+		)
+	}
+
+	return nil
+}
+
+// polynomialDegree returns the degree of polynomials generated in phase 3.
+// The number of shares required to reconstruct a secret is the degree + 1.
+func (mc *memberCore) polynomialDegree() int {
+	if mc.customPolynomialDegree > 0 {
+		return mc.customPolynomialDegree
+	}
+	return mc.group.DishonestThreshold()
+}
+
 // InitializeEphemeralKeysGeneration performs a transition of a member state
 // from the local state to phase 1 of the protocol.
 func (lm *LocalMember) InitializeEphemeralKeysGeneration() *EphemeralKeyPairGeneratingMember {
@@ -293,6 +601,7 @@ func (qm *QualifiedMember) InitializeSharing() *SharingMember {
 	return &SharingMember{
 		QualifiedMember:                       qm,
 		receivedValidPeerPublicKeySharePoints: make(map[group.MemberIndex][]*bn256.G2),
+		receivedPeerIndividualPublicKeys:      make(map[group.MemberIndex]*bn256.G2),
 	}
 }
 
@@ -340,30 +649,69 @@ func (rm *ReconstructingMember) individualPublicKey() *bn256.G2 {
 
 // receivedValidPeerIndividualPublicKeys returns individual public keys received
 // from other members which passed the validation. Individual public key is zeroth
-// public key share point `A_j0`.
+// public key share point `A_j0`. Keys are ordered by sender's member index so
+// that all members process them in the same order.
 func (sm *SharingMember) receivedValidPeerIndividualPublicKeys() []*bn256.G2 {
+	senderIDs := make(
+		[]group.MemberIndex,
+		0,
+		len(sm.receivedValidPeerPublicKeySharePoints),
+	)
+	for senderID := range sm.receivedValidPeerPublicKeySharePoints {
+		senderIDs = append(senderIDs, senderID)
+	}
+	group.SortMemberIndexes(senderIDs)
+
 	var receivedValidPeerIndividualPublicKeys []*bn256.G2
 
-	for _, peerPublicKeySharePoints := range sm.receivedValidPeerPublicKeySharePoints {
+	for _, senderID := range senderIDs {
 		receivedValidPeerIndividualPublicKeys = append(
 			receivedValidPeerIndividualPublicKeys,
-			peerPublicKeySharePoints[0],
+			sm.receivedValidPeerPublicKeySharePoints[senderID][0],
 		)
 	}
 	return receivedValidPeerIndividualPublicKeys
 }
 
+// reconstructedPeerIndividualPublicKeys returns individual public keys of
+// misbehaved members reconstructed in Phase 11, ordered by member index.
+func (rm *ReconstructingMember) reconstructedPeerIndividualPublicKeys() []*bn256.G2 {
+	memberIDs := make(
+		[]group.MemberIndex,
+		0,
+		len(rm.reconstructedIndividualPublicKeys),
+	)
+	for memberID := range rm.reconstructedIndividualPublicKeys {
+		memberIDs = append(memberIDs, memberID)
+	}
+	group.SortMemberIndexes(memberIDs)
+
+	var reconstructedPeerIndividualPublicKeys []*bn256.G2
+
+	for _, memberID := range memberIDs {
+		reconstructedPeerIndividualPublicKeys = append(
+			reconstructedPeerIndividualPublicKeys,
+			rm.reconstructedIndividualPublicKeys[memberID],
+		)
+	}
+	return reconstructedPeerIndividualPublicKeys
+}
+
 // Result can be either the successful computation of a round of distributed key
 // generation, or a notification of failure.
 // It returns the generated group public key and a private key share of a group
 // key along with the disqualified and inactive members (as part of including the
 // group state). The group private key share is used for signing and should never
-// be revealed publicly.
+// be revealed publicly. The returned group private key share is a copy, so it
+// stays valid after member's secrets are wiped.
 func (fm *FinalizingMember) Result() *Result {
 	return &Result{
 		Group:                       fm.group,
 		GroupPublicKey:              fm.groupPublicKey, // nil if threshold not satisfied
-		GroupPrivateKeyShare:        fm.groupPrivateKeyShare,
+		GroupPrivateKeyShare:        new(big.Int).Set(fm.groupPrivateKeyShare),
+		PolynomialDegree:            fm.polynomialDegree(),
+		Disqualifications:           fm.disqualifications(),
+		ReconstructionErrors:        append([]error{}, fm.reconstructionErrors...),
 		groupPublicKeySharesChannel: fm.groupPublicKeySharesChannel,
 	}
 }
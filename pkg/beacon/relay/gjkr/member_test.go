@@ -0,0 +1,134 @@
+package gjkr_test
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// TestCommitmentsVerificationWithExportedAPI executes phases 1-4 of the
+// protocol using only the API exported by the package.
+func TestCommitmentsVerificationWithExportedAPI(t *testing.T) {
+	groupSize := 5
+	dishonestThreshold := 2
+	seed := big.NewInt(1410)
+
+	ephemeralKeyPairGeneratingMembers := make(
+		[]*gjkr.EphemeralKeyPairGeneratingMember,
+		groupSize,
+	)
+	for i := range ephemeralKeyPairGeneratingMembers {
+		member, err := gjkr.NewMember(
+			group.MemberIndex(i+1),
+			groupSize,
+			dishonestThreshold,
+			nil,
+			seed,
+			crand.Reader,
+			nil,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ephemeralKeyPairGeneratingMembers[i] =
+			member.InitializeEphemeralKeysGeneration()
+	}
+
+	// Phase 1
+	ephemeralPublicKeyMessages := make(
+		[]*gjkr.EphemeralPublicKeyMessage,
+		groupSize,
+	)
+	for i, member := range ephemeralKeyPairGeneratingMembers {
+		message, err := member.GenerateEphemeralKeyPair()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ephemeralPublicKeyMessages[i] = message
+	}
+
+	// Phase 2
+	committingMembers := make([]*gjkr.CommittingMember, groupSize)
+	for i, member := range ephemeralKeyPairGeneratingMembers {
+		symmetricKeyGeneratingMember := member.InitializeSymmetricKeyGeneration()
+
+		var receivedMessages []*gjkr.EphemeralPublicKeyMessage
+		for _, message := range ephemeralPublicKeyMessages {
+			if message.SenderID() != group.MemberIndex(i+1) {
+				receivedMessages = append(receivedMessages, message)
+			}
+		}
+
+		err := symmetricKeyGeneratingMember.GenerateSymmetricKeys(
+			receivedMessages,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		committingMembers[i] = symmetricKeyGeneratingMember.InitializeCommitting()
+	}
+
+	// Phase 3
+	peerSharesMessages := make([]*gjkr.PeerSharesMessage, groupSize)
+	commitmentsMessages := make([]*gjkr.MemberCommitmentsMessage, groupSize)
+	for i, member := range committingMembers {
+		sharesMessage, commitmentsMessage, err :=
+			member.CalculateMembersSharesAndCommitments()
+		if err != nil {
+			t.Fatal(err)
+		}
+		peerSharesMessages[i] = sharesMessage
+		commitmentsMessages[i] = commitmentsMessage
+	}
+
+	// Phase 4
+	for i, member := range committingMembers {
+		memberID := group.MemberIndex(i + 1)
+		commitmentsVerifyingMember := member.InitializeCommitmentsVerification()
+
+		var receivedSharesMessages []*gjkr.PeerSharesMessage
+		for _, message := range peerSharesMessages {
+			if message.SenderID() != memberID {
+				receivedSharesMessages = append(receivedSharesMessages, message)
+			}
+		}
+		var receivedCommitmentsMessages []*gjkr.MemberCommitmentsMessage
+		for _, message := range commitmentsMessages {
+			if message.SenderID() != memberID {
+				receivedCommitmentsMessages = append(
+					receivedCommitmentsMessages,
+					message,
+				)
+			}
+		}
+
+		accusationsMessage, err := commitmentsVerifyingMember.
+			VerifyReceivedSharesAndCommitmentsMessages(
+				receivedSharesMessages,
+				receivedCommitmentsMessages,
+			)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if accusationsMessage.SenderID() != memberID {
+			t.Errorf(
+				"unexpected sender of accusations\nexpected: %v\nactual:   %v",
+				memberID,
+				accusationsMessage.SenderID(),
+			)
+		}
+		if len(accusationsMessage.AccusedMemberIDs()) != 0 {
+			t.Errorf(
+				"unexpected accusations of member [%v]: [%v]",
+				memberID,
+				accusationsMessage.AccusedMemberIDs(),
+			)
+		}
+	}
+}
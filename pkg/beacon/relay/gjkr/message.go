@@ -64,6 +64,9 @@ type SecretSharesAccusationsMessage struct {
 	senderID group.MemberIndex
 
 	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey
+
+	// Sender's signature over the accusations; nil if not signed.
+	authentication *messageAuthentication
 }
 
 // MemberPublicKeySharePointsMessage is a message payload that carries the
@@ -86,6 +89,9 @@ type PointsAccusationsMessage struct {
 	senderID group.MemberIndex
 
 	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey
+
+	// Sender's signature over the accusations; nil if not signed.
+	authentication *messageAuthentication
 }
 
 // MisbehavedEphemeralKeysMessage is a message payload that carries sender's
@@ -118,6 +124,12 @@ func (ssam *SecretSharesAccusationsMessage) SenderID() group.MemberIndex {
 	return ssam.senderID
 }
 
+// AccusedMemberIDs returns identifiers of members accused by the sender in
+// the order of their indexes.
+func (ssam *SecretSharesAccusationsMessage) AccusedMemberIDs() []group.MemberIndex {
+	return accusedMemberIDs(ssam.accusedMembersKeys)
+}
+
 // SenderID returns protocol-level identifier of the message sender.
 func (mpkspm *MemberPublicKeySharePointsMessage) SenderID() group.MemberIndex {
 	return mpkspm.senderID
@@ -128,6 +140,23 @@ func (pam *PointsAccusationsMessage) SenderID() group.MemberIndex {
 	return pam.senderID
 }
 
+// AccusedMemberIDs returns identifiers of members accused by the sender in
+// the order of their indexes.
+func (pam *PointsAccusationsMessage) AccusedMemberIDs() []group.MemberIndex {
+	return accusedMemberIDs(pam.accusedMembersKeys)
+}
+
+func accusedMemberIDs(
+	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey,
+) []group.MemberIndex {
+	accusedIDs := make([]group.MemberIndex, 0, len(accusedMembersKeys))
+	for accusedID := range accusedMembersKeys {
+		accusedIDs = append(accusedIDs, accusedID)
+	}
+	group.SortMemberIndexes(accusedIDs)
+	return accusedIDs
+}
+
 // SenderID returns protocol-level identifier of the message sender.
 func (mekm *MisbehavedEphemeralKeysMessage) SenderID() group.MemberIndex {
 	return mekm.senderID
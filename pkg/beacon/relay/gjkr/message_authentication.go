@@ -0,0 +1,110 @@
+package gjkr
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// messageAuthentication carries the sender's signature over the content of
+// a protocol message.
+type messageAuthentication struct {
+	signature []byte
+	publicKey []byte // sender's operator public key
+}
+
+// messageSignableBytes returns bytes signed by the sender of a message.
+// The message type and the seed of the key generation are a part of the
+// signed bytes so that the signed content can not be presented as another
+// message or in another key generation.
+func messageSignableBytes(
+	messageType string,
+	seed *big.Int,
+	senderID group.MemberIndex,
+	content []byte,
+) []byte {
+	seedBytes := seed.Bytes()
+
+	signable := []byte(messageType)
+	signable = append(signable, uint64Bytes(uint64(len(seedBytes)))...)
+	signable = append(signable, seedBytes...)
+	signable = append(signable, byte(senderID))
+	signable = append(signable, content...)
+
+	return signable
+}
+
+func uint64Bytes(value uint64) []byte {
+	bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bytes, value)
+	return bytes
+}
+
+// authenticateMessage signs the content of the member's message of the given
+// type. It returns nil if the member has no signer.
+func (mc *memberCore) authenticateMessage(
+	messageType string,
+	content []byte,
+) (*messageAuthentication, error) {
+	if mc.signing == nil {
+		return nil, nil
+	}
+
+	signature, err := mc.signing.Sign(
+		messageSignableBytes(
+			messageType,
+			mc.protocolParameters.seed,
+			mc.ID,
+			content,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign [%v]: [%v]", messageType, err)
+	}
+
+	return &messageAuthentication{
+		signature: signature,
+		publicKey: mc.signing.PublicKey(),
+	}, nil
+}
+
+// verifyMessageAuthentication checks that the content of a message of the
+// given type has been signed by the operator of the member who sent it.
+func (mc *memberCore) verifyMessageAuthentication(
+	messageType string,
+	senderID group.MemberIndex,
+	content []byte,
+	authentication *messageAuthentication,
+) error {
+	if authentication == nil {
+		return fmt.Errorf("message is not signed")
+	}
+
+	if !mc.membershipValidator.IsValidMembership(
+		senderID,
+		authentication.publicKey,
+	) {
+		return fmt.Errorf("message is not signed by the sender")
+	}
+
+	ok, err := mc.signing.VerifyWithPublicKey(
+		messageSignableBytes(
+			messageType,
+			mc.protocolParameters.seed,
+			senderID,
+			content,
+		),
+		authentication.signature,
+		authentication.publicKey,
+	)
+	if err != nil {
+		return fmt.Errorf("could not verify message signature: [%v]", err)
+	}
+	if !ok {
+		return fmt.Errorf("invalid message signature")
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -90,17 +91,38 @@ func TestNoSharesForReceiver(t *testing.T) {
 	}
 }
 
+func TestAccusedMemberIDs(t *testing.T) {
+	message := &PointsAccusationsMessage{
+		senderID: 1,
+		accusedMembersKeys: map[group.MemberIndex]*ephemeral.PrivateKey{
+			5: nil,
+			2: nil,
+			4: nil,
+		},
+	}
+
+	expectedAccusedIDs := []group.MemberIndex{2, 4, 5}
+	accusedIDs := message.AccusedMemberIDs()
+	if !reflect.DeepEqual(expectedAccusedIDs, accusedIDs) {
+		t.Errorf(
+			"unexpected accused members\nexpected: %v\nactual:   %v",
+			expectedAccusedIDs,
+			accusedIDs,
+		)
+	}
+}
+
 func newTestPeerSharesMessage(senderID, receiverID group.MemberIndex, shareS, shareT *big.Int) (
 	*PeerSharesMessage,
 	ephemeral.SymmetricKey,
 	error,
 ) {
-	keyPair1, err := ephemeral.GenerateKeyPair()
+	keyPair1, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	keyPair2, err := ephemeral.GenerateKeyPair()
+	keyPair2, err := ephemeral.GenerateKeyPair(crand.Reader)
 	if err != nil {
 		return nil, nil, err
 	}
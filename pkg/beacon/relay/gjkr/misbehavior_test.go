@@ -0,0 +1,166 @@
+package gjkr
+
+import (
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/internal/interception"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// The functions below build interception rules turning a chosen member into
+// a dishonest one. Each rule affects only messages sent by the chosen member
+// and leaves messages of all other members intact. Rules can be combined
+// with Misbehave to make several members misbehave in the same test.
+
+// Misbehave combines the provided interception rules into one. Rules are
+// applied in order and once any of them drops the message, it stays dropped.
+func Misbehave(rules ...interception.Rules) interception.Rules {
+	return func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		for _, rule := range rules {
+			msg = rule(msg)
+			if msg == nil {
+				return nil
+			}
+		}
+
+		return msg
+	}
+}
+
+// DropMessages makes the member inactive in the phase in which messages of
+// the given type are sent by dropping all such messages sent by the member.
+func DropMessages(
+	memberID group.MemberIndex,
+	messageType string,
+) interception.Rules {
+	return func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		if isSentBy(msg, memberID) && msg.Type() == messageType {
+			return nil
+		}
+
+		return msg
+	}
+}
+
+// CorruptShares makes the member send shares to the target member which
+// can not be decrypted by the target.
+func CorruptShares(
+	memberID group.MemberIndex,
+	targetID group.MemberIndex,
+) interception.Rules {
+	return func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		sharesMessage, ok := msg.(*PeerSharesMessage)
+		if ok && isSentBy(msg, memberID) {
+			sharesMessage.SetShares(targetID, []byte{0x00}, []byte{0x00})
+		}
+
+		return msg
+	}
+}
+
+// CorruptCommitment makes the member publish a commitment to the coefficient
+// with the given index which is inconsistent with the shares it sent.
+func CorruptCommitment(
+	memberID group.MemberIndex,
+	index int,
+) interception.Rules {
+	return func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		commitmentsMessage, ok := msg.(*MemberCommitmentsMessage)
+		if ok && isSentBy(msg, memberID) {
+			commitmentsMessage.SetCommitment(
+				index,
+				new(bn256.G1).ScalarBaseMult(big.NewInt(1337)),
+			)
+		}
+
+		return msg
+	}
+}
+
+// CorruptPublicKeySharePoint makes the member publish a public key share
+// point with the given index which is inconsistent with the shares it sent.
+func CorruptPublicKeySharePoint(
+	memberID group.MemberIndex,
+	index int,
+) interception.Rules {
+	return func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		sharePointsMessage, ok := msg.(*MemberPublicKeySharePointsMessage)
+		if ok && isSentBy(msg, memberID) {
+			sharePointsMessage.SetPublicKeyShare(
+				index,
+				new(bn256.G2).ScalarBaseMult(big.NewInt(5843)),
+			)
+		}
+
+		return msg
+	}
+}
+
+func isSentBy(msg net.TaggedMarshaler, memberID group.MemberIndex) bool {
+	protocolMessage, ok := msg.(group.ProtocolMessage)
+	return ok && protocolMessage.SenderID() == memberID
+}
+
+func TestMisbehaviorRules(t *testing.T) {
+	newSharesMessage := func(senderID group.MemberIndex) *PeerSharesMessage {
+		return &PeerSharesMessage{
+			senderID: senderID,
+			shares: map[group.MemberIndex]*peerShares{
+				2: {encryptedShareS: []byte{0x01}, encryptedShareT: []byte{0x02}},
+			},
+		}
+	}
+
+	rules := Misbehave(
+		CorruptShares(1, 2),
+		DropMessages(3, newSharesMessage(3).Type()),
+	)
+
+	var tests = map[string]struct {
+		message        *PeerSharesMessage
+		expectedDrop   bool
+		expectedShareS []byte
+	}{
+		"message of the member corrupting shares": {
+			message:        newSharesMessage(1),
+			expectedShareS: []byte{0x00},
+		},
+		"message of the member dropping messages": {
+			message:      newSharesMessage(3),
+			expectedDrop: true,
+		},
+		"message of an honest member": {
+			message:        newSharesMessage(4),
+			expectedShareS: []byte{0x01},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			result := rules(test.message)
+
+			if test.expectedDrop {
+				if result != nil {
+					t.Fatalf("expected message to be dropped")
+				}
+				return
+			}
+
+			if result != test.message {
+				t.Fatalf("expected the same message to be passed on")
+			}
+
+			shareS := test.message.shares[2].encryptedShareS
+			if string(shareS) != string(test.expectedShareS) {
+				t.Errorf(
+					"unexpected share\nexpected: %v\nactual:   %v\n",
+					test.expectedShareS,
+					shareS,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package gjkr
+
+// ProgressCallback is notified each time a member completes a phase of the
+// protocol. It receives the number of the completed phase, the number of phase
+// messages accepted from other members and the number of messages expected
+// from other operating members in that phase. Phases in which no messages are
+// exchanged report zero for both counts.
+//
+// The callback is purely observational. It is called synchronously from
+// the protocol execution, so it should return quickly.
+type ProgressCallback func(phase int, receivedCount int, expectedCount int)
+
+// reportPhaseCompleted notifies the member's progress callback about
+// the completed phase. The expected number of messages is calculated from
+// the number of messages each other operating member sends in the phase.
+// Nothing is reported if the member has no progress callback.
+func (mc *memberCore) reportPhaseCompleted(
+	phase int,
+	receivedCount int,
+	messagesPerMember int,
+) {
+	if mc.progressCallback == nil {
+		return
+	}
+
+	otherOperatingMembers := 0
+	for _, memberID := range mc.group.OperatingMemberIDs() {
+		if memberID != mc.ID {
+			otherOperatingMembers++
+		}
+	}
+
+	mc.progressCallback(
+		phase,
+		receivedCount,
+		messagesPerMember*otherOperatingMembers,
+	)
+}
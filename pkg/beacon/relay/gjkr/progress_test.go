@@ -0,0 +1,92 @@
+package gjkr
+
+import (
+	"context"
+	crand "crypto/rand"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+type phaseProgress struct {
+	phase         int
+	receivedCount int
+	expectedCount int
+}
+
+func TestProgressCallback(t *testing.T) {
+	groupSize := 3
+	dishonestThreshold := 1
+
+	var progress []phaseProgress
+	progressCallback := func(phase int, receivedCount int, expectedCount int) {
+		progress = append(progress, phaseProgress{
+			phase,
+			receivedCount,
+			expectedCount,
+		})
+	}
+
+	var members []*EphemeralKeyPairGeneratingMember
+	var ephemeralMessages []*EphemeralPublicKeyMessage
+	for i := 1; i <= groupSize; i++ {
+		var callback ProgressCallback
+		if i == 1 {
+			callback = progressCallback
+		}
+
+		member, err := NewMember(
+			group.MemberIndex(i),
+			groupSize,
+			dishonestThreshold,
+			nil,
+			big.NewInt(1907),
+			crand.Reader,
+			callback,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ephemeralMember := member.InitializeEphemeralKeysGeneration()
+		message, err := ephemeralMember.GenerateEphemeralKeyPair()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		members = append(members, ephemeralMember)
+		ephemeralMessages = append(ephemeralMessages, message)
+	}
+
+	// Member 1 receives ephemeral public keys only from member 2 in phase 1,
+	// so member 3 is marked as inactive in phase 2 and only member 2 is
+	// expected to send messages in phase 3. Member 1 receives no messages
+	// in phase 3.
+	ephemeralKeyPairState := &ephemeralKeyPairGenerationState{
+		member:        members[0],
+		phaseMessages: ephemeralMessages[1:2],
+	}
+
+	symmetricKeyState := ephemeralKeyPairState.Next()
+	if err := symmetricKeyState.Initiate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	commitmentState := symmetricKeyState.Next()
+	commitmentState.Next()
+
+	expectedProgress := []phaseProgress{
+		{phase: 1, receivedCount: 1, expectedCount: 2},
+		{phase: 2, receivedCount: 0, expectedCount: 0},
+		{phase: 3, receivedCount: 0, expectedCount: 2},
+	}
+	if !reflect.DeepEqual(expectedProgress, progress) {
+		t.Errorf(
+			"unexpected progress\nexpected: %v\nactual:   %v\n",
+			expectedProgress,
+			progress,
+		)
+	}
+}
@@ -17,18 +17,39 @@ import (
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/internal/wipe"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
 
 // GenerateEphemeralKeyPair takes the group member list and generates an
 // ephemeral ECDH keypair for every other group member. Generated public
-// ephemeral keys are broadcasted within the group.
+// ephemeral keys are broadcasted within the group. Key pairs are saved to
+// the member state only if all of them have been generated; on failure, the
+// key pairs generated so far are wiped and the member state is not changed.
+// The member has to be registered in the group; otherwise, keys would be
+// generated for the wrong set of members and an error is returned.
 //
 // See Phase 1 of the protocol specification.
 func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
 	*EphemeralPublicKeyMessage,
 	error,
 ) {
+	isRegistered := false
+	for _, member := range em.group.MemberIDs() {
+		if member == em.ID {
+			isRegistered = true
+			break
+		}
+	}
+	if !isRegistered {
+		return nil, fmt.Errorf(
+			"%w: member [%v] is not registered in the group",
+			ErrInvalidConfig,
+			em.ID,
+		)
+	}
+
+	ephemeralKeyPairs := make(map[group.MemberIndex]*ephemeral.KeyPair)
 	ephemeralKeys := make(map[group.MemberIndex]*ephemeral.PublicKey)
 
 	// Calculate ephemeral key pair for every other group member
@@ -38,18 +59,50 @@ func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
 			continue
 		}
 
-		ephemeralKeyPair, err := ephemeral.GenerateKeyPair()
+		ephemeralKeyPair, err := ephemeral.GenerateKeyPair(em.randomSource)
 		if err != nil {
+			// Do not leave a partially generated set of keys behind. None of
+			// the keys generated so far has been published so they are
+			// wiped and the member state is left untouched.
+			for _, generatedKeyPair := range ephemeralKeyPairs {
+				generatedKeyPair.PrivateKey.Zeroize()
+			}
 			return nil, err
 		}
 
-		// save the generated ephemeral key to our state
-		em.ephemeralKeyPairs[member] = ephemeralKeyPair
+		ephemeralKeyPairs[member] = ephemeralKeyPair
 
 		// store the public key to the map for the message
 		ephemeralKeys[member] = ephemeralKeyPair.PublicKey
 	}
 
+	// save the generated ephemeral keys to our state only once all of them
+	// have been generated
+	for member, ephemeralKeyPair := range ephemeralKeyPairs {
+		em.ephemeralKeyPairs[member] = ephemeralKeyPair
+	}
+
+	if em.ephemeralKeyStore != nil {
+		privateKeys := make(map[group.MemberIndex]*ephemeral.PrivateKey)
+		for member, ephemeralKeyPair := range ephemeralKeyPairs {
+			privateKeys[member] = ephemeralKeyPair.PrivateKey
+		}
+
+		// Keys are still kept in memory so the protocol can continue
+		// unless the member is restarted.
+		if err := em.ephemeralKeyStore.Save(
+			em.ephemeralKeyStoreDirectory,
+			em.ID,
+			privateKeys,
+		); err != nil {
+			logger.Errorf(
+				"[member:%v] could not persist ephemeral private keys: [%v]",
+				em.ID,
+				err,
+			)
+		}
+	}
+
 	return &EphemeralPublicKeyMessage{
 		senderID:            em.ID,
 		ephemeralPublicKeys: ephemeralKeys,
@@ -62,10 +115,19 @@ func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
 // group member, and the public key for this member, generated and broadcasted by
 // the remote group member.
 //
+// If the member has no ephemeral key pairs in memory because it has been
+// restarted, the private keys are loaded from the ephemeral key store, if set.
+//
 // See Phase 2 of the protocol specification.
 func (sm *SymmetricKeyGeneratingMember) GenerateSymmetricKeys(
 	ephemeralPubKeyMessages []*EphemeralPublicKeyMessage,
 ) error {
+	if len(sm.ephemeralKeyPairs) == 0 && sm.ephemeralKeyStore != nil {
+		if err := sm.loadEphemeralKeyPairs(); err != nil {
+			return err
+		}
+	}
+
 	for _, ephemeralPubKeyMessage := range ephemeralPubKeyMessages {
 		otherMember := ephemeralPubKeyMessage.senderID
 
@@ -76,7 +138,11 @@ func (sm *SymmetricKeyGeneratingMember) GenerateSymmetricKeys(
 				sm.ID,
 				otherMember,
 			)
-			sm.group.MarkMemberAsDisqualified(otherMember)
+			sm.disqualify(
+				otherMember,
+				2,
+				"sent invalid ephemeral public key message",
+			)
 			continue
 		}
 
@@ -117,9 +183,31 @@ func (sm *SymmetricKeyGeneratingMember) GenerateSymmetricKeys(
 	return nil
 }
 
+// loadEphemeralKeyPairs restores ephemeral key pairs generated by the member
+// in phase 1 from private keys persisted in the ephemeral key store.
+func (sm *SymmetricKeyGeneratingMember) loadEphemeralKeyPairs() error {
+	privateKeys, err := sm.ephemeralKeyStore.Load(
+		sm.ephemeralKeyStoreDirectory,
+		sm.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("could not load ephemeral private keys: [%v]", err)
+	}
+
+	for member, privateKey := range privateKeys {
+		sm.ephemeralKeyPairs[member] = &ephemeral.KeyPair{
+			PrivateKey: privateKey,
+			PublicKey:  (*ephemeral.PublicKey)(&privateKey.PublicKey),
+		}
+	}
+
+	return nil
+}
+
 // isValidEphemeralPublicKeyMessage validates a given EphemeralPublicKeyMessage.
 // Message is considered valid if it contains ephemeral public keys for
-// all other group members.
+// all other group members and no public keys for anyone else, what could
+// indicate the sender is working with a different group size.
 func (sm *SymmetricKeyGeneratingMember) isValidEphemeralPublicKeyMessage(
 	message *EphemeralPublicKeyMessage,
 ) bool {
@@ -141,6 +229,19 @@ func (sm *SymmetricKeyGeneratingMember) isValidEphemeralPublicKeyMessage(
 		}
 	}
 
+	expectedPublicKeysCount := sm.group.GroupSize() - 1
+	if len(message.ephemeralPublicKeys) != expectedPublicKeysCount {
+		logger.Warningf(
+			"[member:%v] ephemeral public key message from member [%v] "+
+				"contains [%v] public keys instead of expected [%v]",
+			sm.ID,
+			message.senderID,
+			len(message.ephemeralPublicKeys),
+			expectedPublicKeysCount,
+		)
+		return false
+	}
+
 	return true
 }
 
@@ -152,7 +253,8 @@ func (sm *SymmetricKeyGeneratingMember) isValidEphemeralPublicKeyMessage(
 // polynomial using second's polynomial `b` coefficients.
 //
 // If there are no symmetric keys established with all other group members,
-// function yields an error.
+// function yields an error. Function yields an error as well if any of
+// the group members' indices can not be used as an evaluation point.
 //
 // See Phase 3 of the protocol specification.
 func (cm *CommittingMember) CalculateMembersSharesAndCommitments() (
@@ -160,7 +262,13 @@ func (cm *CommittingMember) CalculateMembersSharesAndCommitments() (
 	*MemberCommitmentsMessage,
 	error,
 ) {
-	polynomialDegree := cm.group.DishonestThreshold()
+	for _, memberID := range cm.group.MemberIDs() {
+		if err := validateEvaluationPoint(memberID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	polynomialDegree := cm.polynomialDegree()
 	coefficientsA, err := generatePolynomial(polynomialDegree)
 	if err != nil {
 		return nil, nil, fmt.Errorf(
@@ -236,8 +344,8 @@ func (cm *CommittingMember) calculateCommitment(
 	secret *big.Int,
 	t *big.Int,
 ) *bn256.G1 {
-	gs := new(bn256.G1).ScalarBaseMult(secret)                 // G * secret
-	ht := new(bn256.G1).ScalarMult(cm.protocolParameters.H, t) // H * t
+	gs := cm.protocolParameters.multiplyG(secret) // G * secret
+	ht := cm.protocolParameters.multiplyH(t)      // H * t
 
 	return new(bn256.G1).Add(gs, ht) // G * secret + H * t
 }
@@ -269,6 +377,22 @@ func generatePolynomial(degree int) ([]*big.Int, error) {
 	return coefficients, nil
 }
 
+// validateEvaluationPoint checks if the share polynomials can be evaluated at
+// the given member index. Polynomial evaluated at `0 mod q` is equal to its
+// constant coefficient so a share calculated for such a point would reveal
+// the secret of the member.
+func validateEvaluationPoint(memberID group.MemberIndex) error {
+	point := new(big.Int).Mod(group.MemberIndexToBigInt(memberID), bn256.Order)
+	if point.Sign() == 0 {
+		return fmt.Errorf(
+			"%w: member index [%v] maps to evaluation point 0",
+			ErrInvalidConfig,
+			memberID,
+		)
+	}
+	return nil
+}
+
 // evaluateMemberShare calculates a share for given memberID.
 //
 // It calculates `s_j = Σ a_k * j^k mod q`for k in [0..T], where:
@@ -280,18 +404,26 @@ func (cm *CommittingMember) evaluateMemberShare(
 	memberID group.MemberIndex,
 	coefficients []*big.Int,
 ) *big.Int {
+	return evaluatePolynomial(
+		coefficients,
+		group.MemberIndexToBigInt(memberID),
+		bn256.Order,
+	)
+}
+
+// evaluatePolynomial evaluates the polynomial with the given coefficients at
+// point `x` modulo `q` using Horner's method:
+//
+// `a_0 + x * (a_1 + x * (a_2 + ... + x * a_T)) mod q`
+//
+// Coefficients are ordered from the constant one. Horner's method takes T
+// multiplications instead of computing each power of `x` separately.
+func evaluatePolynomial(coefficients []*big.Int, x, q *big.Int) *big.Int {
 	result := big.NewInt(0)
-	for k, a := range coefficients {
-		result = new(big.Int).Mod(
-			new(big.Int).Add(
-				result,
-				new(big.Int).Mul(
-					a,
-					pow(memberID, k),
-				),
-			),
-			bn256.Order,
-		)
+	for k := len(coefficients) - 1; k >= 0; k-- {
+		result.Mul(result, x)
+		result.Add(result, coefficients[k])
+		result.Mod(result, q)
 	}
 	return result
 }
@@ -309,6 +441,12 @@ func (cm *CommittingMember) evaluateMemberShare(
 // - shares can not be decrypted
 // - shares are not valid against commitments
 //
+// If the member has a limit of accusations set, verification stops as soon as
+// the limit is reached and shares from the remaining members are not verified.
+// The protocol can not succeed anymore in such case.
+//
+// If the member has a signer, the accusation message is signed.
+//
 // See Phase 4 of the protocol specification.
 func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessages(
 	sharesMessages []*PeerSharesMessage,
@@ -333,7 +471,11 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 				cvm.ID,
 				commitmentsMessage.senderID,
 			)
-			cvm.group.MarkMemberAsDisqualified(commitmentsMessage.senderID)
+			cvm.disqualify(
+				commitmentsMessage.senderID,
+				4,
+				"sent invalid member commitments message",
+			)
 			continue
 		}
 
@@ -353,7 +495,11 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 						cvm.ID,
 						sharesMessage.senderID,
 					)
-					cvm.group.MarkMemberAsDisqualified(sharesMessage.senderID)
+					cvm.disqualify(
+						sharesMessage.senderID,
+						4,
+						"sent invalid peer shares message",
+					)
 					break
 				}
 
@@ -367,7 +513,8 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 				symmetricKey, hasKey := cvm.symmetricKeys[sharesMessage.senderID]
 				if !hasKey {
 					return nil, fmt.Errorf(
-						"no symmetric key for sender %v",
+						"%w: no symmetric key for sender %v",
+						ErrVerificationFailed,
 						sharesMessage.senderID,
 					)
 				}
@@ -391,7 +538,11 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 						cvm.ID,
 						sharesMessage.senderID,
 					)
-					cvm.group.MarkMemberAsDisqualified(sharesMessage.senderID)
+					cvm.disqualify(
+						sharesMessage.senderID,
+						4,
+						"sent shares that could not be decrypted",
+					)
 					accusedMembersKeys[sharesMessage.senderID] =
 						cvm.ephemeralKeyPairs[sharesMessage.senderID].PrivateKey
 					break
@@ -409,7 +560,11 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 						cvm.ID,
 						commitmentsMessage.senderID,
 					)
-					cvm.group.MarkMemberAsDisqualified(commitmentsMessage.senderID)
+					cvm.disqualify(
+						commitmentsMessage.senderID,
+						4,
+						"sent shares invalid against commitments",
+					)
 					accusedMembersKeys[commitmentsMessage.senderID] =
 						cvm.ephemeralKeyPairs[commitmentsMessage.senderID].PrivateKey
 					break
@@ -424,12 +579,34 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 				commitmentsMessage.senderID,
 			)
 		}
+
+		if cvm.maxSharesAccusations > 0 &&
+			len(accusedMembersKeys) >= cvm.maxSharesAccusations {
+			logger.Warningf(
+				"[member:%v] stopping shares verification; "+
+					"reached the limit of [%v] accused members",
+				cvm.ID,
+				cvm.maxSharesAccusations,
+			)
+			break
+		}
 	}
 
-	return &SecretSharesAccusationsMessage{
+	message := &SecretSharesAccusationsMessage{
 		senderID:           cvm.ID,
 		accusedMembersKeys: accusedMembersKeys,
-	}, nil
+	}
+
+	authentication, err := cvm.authenticateAccusations(
+		message.Type(),
+		accusedMembersKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+	message.authentication = authentication
+
+	return message, nil
 }
 
 // isValidMemberCommitmentsMessage validates a given MemberCommitmentsMessage.
@@ -438,10 +615,11 @@ func (cvm *CommitmentsVerifyingMember) isValidMemberCommitmentsMessage(
 	message *MemberCommitmentsMessage,
 ) bool {
 	// A commitment is generated for each coefficient of the polynomial.
-	// The polynomial is of degree equal to the dishonest threshold, thus we
-	// have dishonest threshold + 1 coefficients in the polynomial including a
-	// constant coefficient. It implicates the same count of commitments.
-	expectedCommitmentsCount := cvm.group.DishonestThreshold() + 1
+	// The polynomial is of the configured degree, by default equal to the
+	// dishonest threshold, thus we have degree + 1 coefficients in the
+	// polynomial including a constant coefficient. It implicates the same
+	// count of commitments.
+	expectedCommitmentsCount := cvm.polynomialDegree() + 1
 	if len(message.commitments) != expectedCommitmentsCount {
 		logger.Warningf(
 			"[member:%v] member [%v] sent a message with a wrong number "+
@@ -458,10 +636,25 @@ func (cvm *CommitmentsVerifyingMember) isValidMemberCommitmentsMessage(
 }
 
 // isValidPeerSharesMessage validates a given PeerSharesMessage.
-// Message is considered valid if it contains shares for all other group members.
+// Message is considered valid if it contains shares for all other group members
+// and no shares for the sender or members outside of the group, what could
+// indicate the sender is working with a different group size.
 func (cvm *CommitmentsVerifyingMember) isValidPeerSharesMessage(
 	message *PeerSharesMessage,
 ) bool {
+	for receiverID := range message.shares {
+		if receiverID == message.senderID || !cvm.isGroupMember(receiverID) {
+			logger.Warningf(
+				"[member:%v] peer shares message from member [%v] "+
+					"contains shares for unexpected member [%v]",
+				cvm.ID,
+				message.senderID,
+				receiverID,
+			)
+			return false
+		}
+	}
+
 	for _, memberID := range cvm.group.OperatingMemberIDs() {
 		if memberID == message.senderID {
 			// Message contains shares only for other group members.
@@ -483,6 +676,20 @@ func (cvm *CommitmentsVerifyingMember) isValidPeerSharesMessage(
 	return true
 }
 
+// isGroupMember checks if the member with the given index has been initially
+// selected to the group.
+func (cvm *CommitmentsVerifyingMember) isGroupMember(
+	memberID group.MemberIndex,
+) bool {
+	for _, groupMemberID := range cvm.group.MemberIDs() {
+		if groupMemberID == memberID {
+			return true
+		}
+	}
+
+	return false
+}
+
 // areSharesValidAgainstCommitments verifies if commitments are valid for passed
 // shares.
 //
@@ -517,7 +724,7 @@ func (cm *CommittingMember) areSharesValidAgainstCommitments(
 
 	commitment := cm.calculateCommitment(shareS, shareT) // G * s_ji + H * t_ji
 
-	return commitment.String() == sum.String()
+	return constantTimeG1Equal(commitment, sum)
 }
 
 // ResolveSecretSharesAccusationsMessages resolves complaints received in
@@ -532,6 +739,11 @@ func (cm *CommittingMember) areSharesValidAgainstCommitments(
 // without checking self shares. Each member consider itself as an honest
 // participant.
 //
+// Accusations in which the accuser accuses itself are ignored as invalid.
+// Mutual accusations are resolved independently, each of them against shares
+// sent by the accused party, so only the party whose shares are inconsistent
+// is disqualified, unless both accusations are false.
+//
 // This function needs to decrypt shares sent previously by the accused member
 // to the accuser in an encrypted form. To do that it needs to recover a symmetric
 // key used for data encryption. It takes private key revealed by the accuser
@@ -561,13 +773,29 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 	for _, message := range messages {
 		accuserID := message.senderID
 		for accusedID, revealedAccuserPrivateKey := range message.accusedMembersKeys {
+			if accuserID == accusedID {
+				// A member can not accuse itself. Such an accusation can not
+				// be resolved since the accuser has not generated an ephemeral
+				// key for itself, so the accusation is ignored.
+				logger.Warningf(
+					"[member:%v] ignoring self-accusation of member [%v]",
+					sjm.ID,
+					accuserID,
+				)
+				continue
+			}
+
 			isAccusedIDValid := accusedID > 0 && int(accusedID) <= sjm.group.GroupSize()
 			if sjm.ID == accusedID || !isAccusedIDValid {
 				// The member does not resolve the dispute as an accused
 				// or the accussed member ID is not valid.
 				// Mark the accuser as disqualified immediately,
 				// as each member consider itself as a honest participant.
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.disqualify(
+					accuserID,
+					5,
+					"accused the current member or a member with invalid index",
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -589,7 +817,8 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 				// If the public key could not be found we consider this a
 				// fatal error. Such a situation should never happen.
 				return fmt.Errorf(
-					"could not find public key sent by [%v] to [%v]",
+					"%w: could not find public key sent by [%v] to [%v]",
+					ErrVerificationFailed,
 					accuserID,
 					accusedID,
 				)
@@ -602,7 +831,11 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					sjm.ID,
 					accuserID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.disqualify(
+					accuserID,
+					5,
+					"revealed private key not matching the public key",
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -640,7 +873,11 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accuserID,
 					accusedID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.disqualify(
+					accuserID,
+					5,
+					"accused member already inactive or disqualified in phase 2",
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -663,7 +900,11 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accuserID,
 					accusedID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.disqualify(
+					accuserID,
+					5,
+					"accused member inactive in phase 4",
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -686,7 +927,11 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accusedID,
 					accuserID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accusedID)
+				sjm.disqualify(
+					accusedID,
+					5,
+					"sent shares that could not be decrypted to the accuser",
+				)
 				sjm.discardReceivedShares(accusedID)
 				continue
 			}
@@ -703,7 +948,7 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accuserID,
 					accusedID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.disqualify(accuserID, 5, "false accusation")
 				sjm.discardReceivedShares(accuserID)
 			} else {
 				logger.Warningf(
@@ -713,7 +958,11 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accusedID,
 					accuserID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accusedID)
+				sjm.disqualify(
+					accusedID,
+					5,
+					"confirmed misbehaviour against the accuser",
+				)
 				sjm.discardReceivedShares(accusedID)
 			}
 		}
@@ -796,9 +1045,11 @@ func findPublicKey(
 // accusations stage. `q` is the order of cyclic group formed over the alt_bn128
 // curve.
 //
+// Data received in phase 3 which is no longer needed is released afterwards.
+//
 // See Phase 6 of the protocol specification.
 func (qm *QualifiedMember) CombineMemberShares() {
-	combinedSharesS := qm.selfSecretShareS // s_ii
+	combinedSharesS := new(big.Int).Set(qm.selfSecretShareS) // s_ii
 	for _, s := range qm.receivedQualifiedSharesS {
 		combinedSharesS = new(big.Int).Mod(
 			new(big.Int).Add(combinedSharesS, s),
@@ -807,6 +1058,27 @@ func (qm *QualifiedMember) CombineMemberShares() {
 	}
 
 	qm.groupPrivateKeyShare = combinedSharesS
+
+	qm.releaseSharesJustificationData()
+}
+
+// releaseSharesJustificationData releases data received in phase 3 which is
+// not needed once member shares are combined. Shares `t_ji` are used only to
+// resolve accusations in phase 5, so they are wiped and released. Commitments
+// of members outside of QUAL are released as well; commitments of QUAL
+// members are still needed to reconstruct keys of members disqualified or
+// marked as inactive in later phases.
+func (qm *QualifiedMember) releaseSharesJustificationData() {
+	for _, share := range qm.receivedQualifiedSharesT {
+		wipe.Int(share)
+	}
+	qm.receivedQualifiedSharesT = nil
+
+	for memberID := range qm.receivedPeerCommitments {
+		if _, ok := qm.receivedQualifiedSharesS[memberID]; !ok {
+			delete(qm.receivedPeerCommitments, memberID)
+		}
+	}
 }
 
 // CalculatePublicKeySharePoints calculates public values for member's
@@ -835,7 +1107,7 @@ func (sm *SharingMember) CalculatePublicKeySharePoints() *MemberPublicKeySharePo
 // VerifyPublicKeySharePoints validates public key share points received in
 // messages from peer group members.
 // It returns accusation message with ID of members for which the verification
-// failed.
+// failed. If the member has a signer, the accusation message is signed.
 //
 // See Phase 8 of the protocol specification.
 func (sm *SharingMember) VerifyPublicKeySharePoints(
@@ -845,6 +1117,13 @@ func (sm *SharingMember) VerifyPublicKeySharePoints(
 	// `product = Π (A_j[k] ^ (i^k)) mod p` for k in [0..T],
 	// where: j is sender's ID, i is current member ID, T is dishonest threshold.
 	for _, message := range messages {
+		if len(message.publicKeySharePoints) > 0 {
+			// A_j0, kept to cross-check the individual public key if
+			// it has to be reconstructed in phase 11.
+			sm.receivedPeerIndividualPublicKeys[message.senderID] =
+				message.publicKeySharePoints[0]
+		}
+
 		if !sm.isValidMemberPublicKeySharePointsMessage(message) {
 			logger.Warningf(
 				"[member:%v] member [%v] disqualified because of "+
@@ -852,7 +1131,11 @@ func (sm *SharingMember) VerifyPublicKeySharePoints(
 				sm.ID,
 				message.senderID,
 			)
-			sm.group.MarkMemberAsDisqualified(message.senderID)
+			sm.disqualify(
+				message.senderID,
+				8,
+				"sent invalid member public key share points message",
+			)
 			continue
 		}
 
@@ -867,17 +1150,32 @@ func (sm *SharingMember) VerifyPublicKeySharePoints(
 				sm.ID,
 				message.senderID,
 			)
-			sm.group.MarkMemberAsDisqualified(message.senderID)
+			sm.disqualify(
+				message.senderID,
+				8,
+				"sent invalid public key share points",
+			)
 			accusedMembersKeys[message.senderID] = sm.ephemeralKeyPairs[message.senderID].PrivateKey
 			continue
 		}
 		sm.receivedValidPeerPublicKeySharePoints[message.senderID] = message.publicKeySharePoints
 	}
 
-	return &PointsAccusationsMessage{
+	message := &PointsAccusationsMessage{
 		senderID:           sm.ID,
 		accusedMembersKeys: accusedMembersKeys,
-	}, nil
+	}
+
+	authentication, err := sm.authenticateAccusations(
+		message.Type(),
+		accusedMembersKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+	message.authentication = authentication
+
+	return message, nil
 }
 
 // isValidMemberPublicKeySharePointsMessage validates a given
@@ -887,11 +1185,11 @@ func (sm *SharingMember) isValidMemberPublicKeySharePointsMessage(
 	message *MemberPublicKeySharePointsMessage,
 ) bool {
 	// A public key share point is generated for each coefficient of the
-	// polynomial. The polynomial is of degree equal to the dishonest threshold,
-	// thus we have dishonest threshold + 1 coefficients in the polynomial
-	// including a constant coefficient. It implicates the same count of
-	// public key share points.
-	expectedPointsCount := sm.group.DishonestThreshold() + 1
+	// polynomial. The polynomial is of the configured degree, by default equal
+	// to the dishonest threshold, thus we have degree + 1 coefficients in the
+	// polynomial including a constant coefficient. It implicates the same
+	// count of public key share points.
+	expectedPointsCount := sm.polynomialDegree() + 1
 	if len(message.publicKeySharePoints) != expectedPointsCount {
 		logger.Warningf(
 			"[member:%v] member [%v] sent a message with a wrong number "+
@@ -933,7 +1231,7 @@ func (sm *SharingMember) isShareValidAgainstPublicKeySharePoints(
 	sum := sm.publicKeyShare(shareReceiverID, publicKeySharePoints)
 	gs := new(bn256.G2).ScalarBaseMult(shareS) // G * s_ji
 
-	return gs.String() == sum.String()
+	return constantTimeG2Equal(gs, sum)
 }
 
 // publicKeyShare returns public key share for given share receiver based on
@@ -967,6 +1265,9 @@ func (sm *SharingMember) publicKeyShare(
 // without checking self shares. Each member consider itself as an honest
 // participant.
 //
+// Self-accusations and mutual accusations are handled the same way as in
+// ResolveSecretSharesAccusationsMessages.
+//
 // This function needs to decrypt shares sent previously by the accused member
 // to the accuser in an encrypted form. To do that it needs to recover a symmetric
 // key used for data encryption. It takes private key revealed by the accuser
@@ -998,13 +1299,29 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 	for _, message := range messages {
 		accuserID := message.senderID
 		for accusedID, revealedAccuserPrivateKey := range message.accusedMembersKeys {
+			if accuserID == accusedID {
+				// A member can not accuse itself. Such an accusation can not
+				// be resolved since the accuser has not generated an ephemeral
+				// key for itself, so the accusation is ignored.
+				logger.Warningf(
+					"[member:%v] ignoring self-accusation of member [%v]",
+					pjm.ID,
+					accuserID,
+				)
+				continue
+			}
+
 			isAccusedIDValid := accusedID > 0 && int(accusedID) <= pjm.group.GroupSize()
 			if pjm.ID == accusedID || !isAccusedIDValid {
 				// The member does not resolve the dispute as an accused
 				// or the accussed member ID is not valid.
 				// Mark the accuser as disqualified immediately,
 				// as each member consider itself as a honest participant.
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.disqualify(
+					accuserID,
+					9,
+					"accused the current member or a member with invalid index",
+				)
 				continue
 			}
 
@@ -1027,7 +1344,8 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 				// If the public key could not be found we consider this a
 				// fatal error. Such a situation should never happen.
 				return fmt.Errorf(
-					"could not find public key sent by [%v] to [%v]",
+					"%w: could not find public key sent by [%v] to [%v]",
+					ErrVerificationFailed,
 					accuserID,
 					accusedID,
 				)
@@ -1040,7 +1358,11 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					pjm.ID,
 					accuserID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.disqualify(
+					accuserID,
+					9,
+					"revealed private key not matching the public key",
+				)
 				continue
 			}
 
@@ -1077,7 +1399,11 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accuserID,
 					accusedID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.disqualify(
+					accuserID,
+					9,
+					"accused member already inactive or disqualified in phase 2",
+				)
 				continue
 			}
 			recoveredSymmetricKey := revealedAccuserPrivateKey.Ecdh(accusedPublicKey)
@@ -1099,7 +1425,11 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accuserID,
 					accusedID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.disqualify(
+					accuserID,
+					9,
+					"accused member inactive in phase 4",
+				)
 				continue
 			}
 
@@ -1125,8 +1455,16 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accusedID,
 					accuserID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
-				pjm.group.MarkMemberAsDisqualified(accusedID)
+				pjm.disqualify(
+					accuserID,
+					9,
+					"did not accuse member sending invalid shares in phase 4",
+				)
+				pjm.disqualify(
+					accusedID,
+					9,
+					"sent shares that could not be decrypted to the accuser",
+				)
 				continue
 			}
 
@@ -1142,7 +1480,7 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accuserID,
 					accusedID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.disqualify(accuserID, 9, "false accusation")
 			} else {
 				logger.Warningf(
 					"[member:%v] member [%v] disqualified because of "+
@@ -1151,7 +1489,11 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accusedID,
 					accuserID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accusedID)
+				pjm.disqualify(
+					accusedID,
+					9,
+					"confirmed misbehaviour against the accuser",
+				)
 			}
 		}
 	}
@@ -1252,19 +1594,33 @@ func (rm *ReconstructingMember) ReconstructMisbehavedIndividualKeys(
 				rm.ID,
 				message.senderID,
 			)
-			rm.group.MarkMemberAsDisqualified(message.senderID)
+			rm.disqualify(
+				message.senderID,
+				11,
+				"sent invalid misbehaved ephemeral keys message",
+			)
 		}
 	}
 
 	revealedMisbehavedMembersShares, err := rm.revealMisbehavedMembersShares(messages)
 	if err != nil {
-		return fmt.Errorf("revealing misbehaved shares failed [%v]", err)
+		return fmt.Errorf("revealing misbehaved shares failed [%w]", err)
 	}
 	// Store for the purpose of combining group public key shares in phase 12.
 	rm.revealedMisbehavedMembersShares = revealedMisbehavedMembersShares
 
-	rm.reconstructIndividualPrivateKeys(revealedMisbehavedMembersShares) // z_m
-	rm.reconstructIndividualPublicKeys()                                 // y_m
+	// z_m
+	if err := rm.reconstructIndividualPrivateKeys(
+		revealedMisbehavedMembersShares,
+	); err != nil {
+		return fmt.Errorf("reconstructing misbehaved keys failed [%w]", err)
+	}
+	rm.reconstructIndividualPublicKeys() // y_m
+
+	// Commitments are used only to validate revealed shares, no later phase
+	// needs them.
+	rm.receivedPeerCommitments = nil
+
 	return nil
 }
 
@@ -1286,7 +1642,10 @@ func (rm *ReconstructingMember) revealMisbehavedMembersShares(
 		for _, shares := range recoveredShares {
 			if shares.misbehavedMemberID == memberID {
 				if currentMemberShare, ok := rm.receivedQualifiedSharesS[memberID]; ok {
-					shares.peerSharesS[rm.ID] = currentMemberShare
+					// Copied, since received shares are wiped once this
+					// phase completes and revealed shares are still used
+					// in phase 12.
+					shares.peerSharesS[rm.ID] = new(big.Int).Set(currentMemberShare)
 				}
 				break
 			}
@@ -1345,7 +1704,11 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 				// Mark the revealing member as disqualified immediately,
 				// as each member consider itself as a honest participant.
 				// Continue as there is no sense to recover own shares.
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.disqualify(
+					revealingMemberID,
+					11,
+					"revealed private key generated for the current member",
+				)
 				continue
 			}
 
@@ -1376,7 +1739,8 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 				// could not be found we consider this a fatal error.
 				// Such a situation should never happen.
 				return nil, fmt.Errorf(
-					"could not find public key sent by [%v] to [%v]",
+					"%w: could not find public key sent by [%v] to [%v]",
+					ErrVerificationFailed,
 					revealingMemberID,
 					misbehavedMemberID,
 				)
@@ -1389,7 +1753,11 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					rm.ID,
 					revealingMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.disqualify(
+					revealingMemberID,
+					11,
+					"revealed private key not matching the public key",
+				)
 				continue
 			}
 
@@ -1430,7 +1798,11 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					revealingMemberID,
 					misbehavedMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.disqualify(
+					revealingMemberID,
+					11,
+					"revealed key of member already inactive or disqualified in phase 2",
+				)
 				continue
 			}
 			recoveredSymmetricKey := revealedPrivateKey.Ecdh(misbehavedMemberPublicKey)
@@ -1451,7 +1823,11 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					rm.ID,
 					revealingMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.disqualify(
+					revealingMemberID,
+					11,
+					"revealed key of member which did not provide shares in phase 3",
+				)
 				continue
 			}
 
@@ -1474,7 +1850,11 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					revealingMemberID,
 					misbehavedMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.disqualify(
+					revealingMemberID,
+					11,
+					"did not accuse member sending shares that could not be decrypted",
+				)
 				continue
 			}
 
@@ -1500,7 +1880,11 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					revealingMemberID,
 					misbehavedMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.disqualify(
+					revealingMemberID,
+					11,
+					"did not accuse member sending inconsistent shares",
+				)
 			}
 		}
 	}
@@ -1565,66 +1949,119 @@ type misbehavedShares struct {
 //
 // Function need to be executed for QUAL members marked as disqualified or inactive.
 //
+// Misbehaved member's polynomial is of degree `T`, equal to the dishonest
+// threshold unless configured otherwise, so exactly `T + 1` shares are needed
+// to reconstruct it. All revealed shares have already been verified against
+// the misbehaved member's commitments, so any `T + 1` of them lie on the same
+// polynomial; the private key is interpolated from the shares of `T + 1` peer
+// members with the lowest IDs and extra shares are not used. If fewer than
+// `T + 1` shares have been revealed, the private key can not be reconstructed
+// and the function fails.
+//
 // It stores a map of reconstructed individual private keys for each misbehaved
 // member in a current member's reconstructedIndividualPrivateKeys field:
 // <misbehavedMemberID, privateKeyShare>
 func (rm *ReconstructingMember) reconstructIndividualPrivateKeys(
 	revealedMisbehavedShares []*misbehavedShares,
-) {
+) error {
 	rm.reconstructedIndividualPrivateKeys = make(map[group.MemberIndex]*big.Int, len(revealedMisbehavedShares))
 
 	for _, ds := range revealedMisbehavedShares { // for each misbehaved member
-		// Reconstruct individual private key `z_m = Σ (s_mk * a_mk) mod q` where:
-		// - `z_m` is misbehaved member's individual private key
-		// - `s_mk` is a share calculated by misbehaved member `m` for peer member `k`
-		// - `a_mk` is lagrange coefficient for peer member k (see below)
-		individualPrivateKey := big.NewInt(0)
 		// Get IDs of all peer members from misbehaved shares.
 		var peerIDs []group.MemberIndex
 		for k := range ds.peerSharesS {
 			peerIDs = append(peerIDs, k)
 		}
-		// For each peerID `k` and peerShareS `s_mk` calculate `s_mk * a_mk`
-		for peerID, peerShareS := range ds.peerSharesS {
-			// a_mk
-			lagrangeCoefficient := rm.calculateLagrangeCoefficient(peerID, peerIDs)
-
-			// Σ (s_mk * a_mk) mod q
-			individualPrivateKey = new(big.Int).Mod(
-				new(big.Int).Add(
-					individualPrivateKey,
-					// s_mk * a_mk
-					new(big.Int).Mul(peerShareS, lagrangeCoefficient),
-				),
-				bn256.Order,
+		group.SortMemberIndexes(peerIDs)
+
+		requiredSharesCount := rm.polynomialDegree() + 1
+		if len(peerIDs) < requiredSharesCount {
+			return fmt.Errorf(
+				"%w: only [%v] shares of misbehaved member [%v] revealed; "+
+					"[%v] are required for reconstruction",
+				ErrInsufficientShares,
+				len(peerIDs),
+				ds.misbehavedMemberID,
+				requiredSharesCount,
 			)
 		}
+
+		interpolationIDs := peerIDs[:requiredSharesCount]
+
+		// Reconstruct individual private key `z_m = Σ (s_mk * a_mk) mod q` where:
+		// - `z_m` is misbehaved member's individual private key
+		// - `s_mk` is a share calculated by misbehaved member `m` for peer member `k`
+		// - `a_mk` is lagrange coefficient for peer member k (see below)
+		individualPrivateKey := interpolateShare(0, ds.peerSharesS, interpolationIDs)
+
 		// <m, z_m>
 		rm.reconstructedIndividualPrivateKeys[ds.misbehavedMemberID] =
 			individualPrivateKey
 	}
+
+	return nil
+}
+
+// interpolateShare evaluates the polynomial of the misbehaved member at
+// the given member ID `x` from shares `s_mk` revealed by peer members `k` with
+// the given IDs: `Σ (s_mk * a_mk(x)) mod q`. For `x = 0`, the result is
+// the misbehaved member's individual private key.
+func interpolateShare(
+	x group.MemberIndex,
+	peerSharesS map[group.MemberIndex]*big.Int,
+	peerIDs []group.MemberIndex,
+) *big.Int {
+	result := big.NewInt(0)
+	// For each peerID `k` and peerShareS `s_mk` calculate `s_mk * a_mk(x)`
+	for _, peerID := range peerIDs {
+		lagrangeCoefficient := calculateLagrangeCoefficientAt(
+			x,
+			peerID,
+			peerIDs,
+		)
+
+		// Σ (s_mk * a_mk(x)) mod q
+		result = new(big.Int).Mod(
+			new(big.Int).Add(
+				result,
+				// s_mk * a_mk(x)
+				new(big.Int).Mul(peerSharesS[peerID], lagrangeCoefficient),
+			),
+			bn256.Order,
+		)
+	}
+
+	return result
 }
 
-// Calculates Lagrange coefficient `a_mk` for member `k` in a group of members.
+// Calculates Lagrange coefficient `a_mk(x)` for member `k` in a group of
+// members, evaluated at point `x`.
 //
-// `a_mk = Π (l / (l - k)) mod q` where:
-// - `a_mk` is a lagrange coefficient for the member `k`,
+// `a_mk(x) = Π ((l - x) / (l - k)) mod q` where:
+// - `a_mk(x)` is a lagrange coefficient for the member `k` at point `x`,
 // - `l` are IDs of members who provided shares,
 // - `q` is an order of alt_bn128 elliptic curve
 // and `l != k`.
-func (rm *ReconstructingMember) calculateLagrangeCoefficient(memberID group.MemberIndex, groupMembersIDs []group.MemberIndex) *big.Int {
+func calculateLagrangeCoefficientAt(
+	x group.MemberIndex,
+	memberID group.MemberIndex,
+	groupMembersIDs []group.MemberIndex,
+) *big.Int {
 	lagrangeCoefficient := big.NewInt(1)
 	// For each otherID `l` in groupMembersIDs:
 	for _, otherID := range groupMembersIDs {
 		if otherID != memberID { // l != k
-			// l / (l - k)
+			// (l - x) / (l - k)
 			quotient := new(big.Int).Mod(
 				new(big.Int).Mul(
-					big.NewInt(int64(otherID)),
+					new(big.Int).Sub(
+						group.MemberIndexToBigInt(otherID),
+						group.MemberIndexToBigInt(x),
+					),
 					new(big.Int).ModInverse(
 						new(big.Int).Sub(
-							big.NewInt(int64(otherID)),
-							big.NewInt(int64(memberID)),
+							group.MemberIndexToBigInt(otherID),
+							group.MemberIndexToBigInt(memberID),
 						),
 						bn256.Order,
 					),
@@ -1632,7 +2069,7 @@ func (rm *ReconstructingMember) calculateLagrangeCoefficient(memberID group.Memb
 				bn256.Order,
 			)
 
-			// Π (l / (l - k)) mod q
+			// Π ((l - x) / (l - k)) mod q
 			lagrangeCoefficient = new(big.Int).Mod(
 				new(big.Int).Mul(
 					lagrangeCoefficient, quotient,
@@ -1641,7 +2078,7 @@ func (rm *ReconstructingMember) calculateLagrangeCoefficient(memberID group.Memb
 			)
 		}
 	}
-	return lagrangeCoefficient // a_mk
+	return lagrangeCoefficient // a_mk(x)
 }
 
 // reconstructIndividualPublicKeys calculates and stores individual public keys
@@ -1650,21 +2087,47 @@ func (rm *ReconstructingMember) calculateLagrangeCoefficient(memberID group.Memb
 // Public key is calculated as `g^privateKey mod p` what, using elliptic curve,
 // is the same as `G * privateKey`.
 //
+// If the misbehaved member broadcast its individual public key `A_m0` in
+// Phase 7, the reconstructed key is cross-checked against it, even if the
+// public key share points of the member have been rejected in Phase 8.
+// A mismatch means the member broadcast `A_m0` inconsistent with its shares or
+// the reconstruction is wrong. The reconstructed key is still used in such
+// case, since it is derived from shares verified against the member's
+// commitments, and the mismatch is recorded as a reconstruction error.
+//
 // See Phase 11 of the protocol specification.
 func (rm *ReconstructingMember) reconstructIndividualPublicKeys() {
 	rm.reconstructedIndividualPublicKeys = make(
 		map[group.MemberIndex]*bn256.G2,
 		len(rm.reconstructedIndividualPrivateKeys),
 	)
+
 	for memberID, individualPrivateKey := range rm.reconstructedIndividualPrivateKeys {
 		// y_m = G * z_m
 		individualPublicKey := new(bn256.G2).ScalarBaseMult(individualPrivateKey)
 		rm.reconstructedIndividualPublicKeys[memberID] = individualPublicKey
+
+		receivedPublicKey, ok := rm.receivedPeerIndividualPublicKeys[memberID]
+		if !ok {
+			continue
+		}
+
+		// y_m == A_m0
+		if !constantTimeG2Equal(individualPublicKey, receivedPublicKey) {
+			err := fmt.Errorf(
+				"%w: reconstructed individual public key of misbehaved "+
+					"member [%v] does not match the one broadcast in phase 7",
+				ErrVerificationFailed,
+				memberID,
+			)
+			logger.Warningf("[member:%v] %v", rm.ID, err)
+			rm.reconstructionErrors = append(rm.reconstructionErrors, err)
+		}
 	}
 }
 
 func pow(id group.MemberIndex, y int) *big.Int {
-	return new(big.Int).Exp(big.NewInt(int64(id)), big.NewInt(int64(y)), nil)
+	return new(big.Int).Exp(group.MemberIndexToBigInt(id), big.NewInt(int64(y)), nil)
 }
 
 // CombineGroupPublicKey calculates a group public key by combining individual
@@ -1695,7 +2158,7 @@ func (cm *CombiningMember) CombineGroupPublicKey() {
 	}
 
 	// Add reconstructed misbehaved members' individual public keys `G * z_m`.
-	for _, peerPublicKey := range cm.reconstructedIndividualPublicKeys {
+	for _, peerPublicKey := range cm.reconstructedPeerIndividualPublicKeys() {
 		groupPublicKey = new(bn256.G2).Add(groupPublicKey, peerPublicKey)
 	}
 
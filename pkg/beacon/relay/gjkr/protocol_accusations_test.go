@@ -418,6 +418,11 @@ func TestResolveSecretSharesAccusationsIncorrectAccussedMemberId(t *testing.T) {
 			accusedID:            0,
 			expectedDisqualified: []group.MemberIndex{2},
 		},
+		"accuser accusing itself - accusation is ignored": {
+			accuserID:            4,
+			accusedID:            4,
+			expectedDisqualified: []group.MemberIndex{},
+		},
 	}
 
 	for testName, test := range tests {
@@ -478,6 +483,11 @@ func TestResolvePublicKeySharePointsAccusationsIncorrectAccusedMemberId(t *testi
 			accusedID:            0,
 			expectedDisqualified: []group.MemberIndex{2},
 		},
+		"accuser accusing itself - accusation is ignored": {
+			accuserID:            4,
+			accusedID:            4,
+			expectedDisqualified: []group.MemberIndex{},
+		},
 	}
 
 	for testName, test := range tests {
@@ -517,6 +527,207 @@ func TestResolvePublicKeySharePointsAccusationsIncorrectAccusedMemberId(t *testi
 	}
 }
 
+func TestResolveSecretSharesMutualAccusations(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	currentMemberID := group.MemberIndex(2)
+
+	var tests = map[string]struct {
+		invalidSharesSenders []group.MemberIndex
+		expectedDisqualified []group.MemberIndex
+	}{
+		"both accusations are false - both are disqualified": {
+			invalidSharesSenders: []group.MemberIndex{},
+			expectedDisqualified: []group.MemberIndex{3, 4},
+		},
+		"accusation of member 3 is true - only member 4 is disqualified": {
+			invalidSharesSenders: []group.MemberIndex{4},
+			expectedDisqualified: []group.MemberIndex{4},
+		},
+		"accusation of member 4 is true - only member 3 is disqualified": {
+			invalidSharesSenders: []group.MemberIndex{3},
+			expectedDisqualified: []group.MemberIndex{3},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeSharesJustifyingMemberGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatalf("group initialization failed [%s]", err)
+			}
+			justifyingMember := findSharesJustifyingMemberByID(members, currentMemberID)
+
+			// Members 3 and 4 accuse each other.
+			disputes := [][2]group.MemberIndex{{3, 4}, {4, 3}}
+
+			var messages []*SecretSharesAccusationsMessage
+			for _, dispute := range disputes {
+				accuserID, accusedID := dispute[0], dispute[1]
+				accuser := findSharesJustifyingMemberByID(members, accuserID)
+
+				shareS := accuser.receivedQualifiedSharesS[accusedID]
+				for _, senderID := range test.invalidSharesSenders {
+					if senderID == accusedID {
+						shareS = new(big.Int).Sub(shareS, big.NewInt(1))
+					}
+				}
+
+				err := putPeerSharesMessage(
+					justifyingMember.evidenceLog,
+					accusedID,
+					accuserID,
+					accuser.symmetricKeys[accusedID],
+					shareS,
+					accuser.receivedQualifiedSharesT[accusedID],
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				messages = append(messages, &SecretSharesAccusationsMessage{
+					senderID: accuserID,
+					accusedMembersKeys: map[group.MemberIndex]*ephemeral.PrivateKey{
+						accusedID: accuser.ephemeralKeyPairs[accusedID].PrivateKey,
+					},
+				})
+			}
+
+			err = justifyingMember.ResolveSecretSharesAccusationsMessages(messages)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actualDisqualified := justifyingMember.group.DisqualifiedMemberIDs()
+			if !reflect.DeepEqual(actualDisqualified, test.expectedDisqualified) {
+				t.Fatalf(
+					"unexpected members disqualified\nexpected: %d\nactual:   %d\n",
+					test.expectedDisqualified,
+					actualDisqualified,
+				)
+			}
+		})
+	}
+}
+
+func TestResolvePublicKeySharePointsMutualAccusations(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	currentMemberID := group.MemberIndex(2)
+
+	var tests = map[string]struct {
+		invalidSharesSenders []group.MemberIndex
+		expectedDisqualified []group.MemberIndex
+	}{
+		"both accusations are false - both are disqualified": {
+			invalidSharesSenders: []group.MemberIndex{},
+			expectedDisqualified: []group.MemberIndex{3, 4},
+		},
+		"accusation of member 3 is true - only member 4 is disqualified": {
+			invalidSharesSenders: []group.MemberIndex{4},
+			expectedDisqualified: []group.MemberIndex{4},
+		},
+		"accusation of member 4 is true - only member 3 is disqualified": {
+			invalidSharesSenders: []group.MemberIndex{3},
+			expectedDisqualified: []group.MemberIndex{3},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializePointsJustifyingMemberGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatalf("group initialization failed [%s]", err)
+			}
+			justifyingMember := findCoefficientsJustifyingMemberByID(members, currentMemberID)
+
+			// Members 3 and 4 accuse each other.
+			disputes := [][2]group.MemberIndex{{3, 4}, {4, 3}}
+
+			var messages []*PointsAccusationsMessage
+			for _, dispute := range disputes {
+				accuserID, accusedID := dispute[0], dispute[1]
+				accuser := findCoefficientsJustifyingMemberByID(members, accuserID)
+
+				shareS := accuser.receivedQualifiedSharesS[accusedID]
+				for _, senderID := range test.invalidSharesSenders {
+					if senderID == accusedID {
+						shareS = new(big.Int).Sub(shareS, big.NewInt(1))
+					}
+				}
+
+				err := putPeerSharesMessage(
+					justifyingMember.evidenceLog,
+					accusedID,
+					accuserID,
+					accuser.symmetricKeys[accusedID],
+					shareS,
+					big.NewInt(13),
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				messages = append(messages, &PointsAccusationsMessage{
+					senderID: accuserID,
+					accusedMembersKeys: map[group.MemberIndex]*ephemeral.PrivateKey{
+						accusedID: accuser.ephemeralKeyPairs[accusedID].PrivateKey,
+					},
+				})
+			}
+
+			err = justifyingMember.ResolvePublicKeySharePointsAccusationsMessages(
+				messages,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actualDisqualified := justifyingMember.group.DisqualifiedMemberIDs()
+			if !reflect.DeepEqual(actualDisqualified, test.expectedDisqualified) {
+				t.Fatalf(
+					"unexpected members disqualified\nexpected: %d\nactual:   %d\n",
+					test.expectedDisqualified,
+					actualDisqualified,
+				)
+			}
+		})
+	}
+}
+
+// putPeerSharesMessage simulates PeerSharesMessage received from the sender
+// with shares for the receiver encrypted with their symmetric key.
+func putPeerSharesMessage(
+	evidenceLog evidenceLog,
+	senderID, receiverID group.MemberIndex,
+	symmetricKey ephemeral.SymmetricKey,
+	shareS, shareT *big.Int,
+) error {
+	encryptedShareS, err := symmetricKey.Encrypt(shareS.Bytes())
+	if err != nil {
+		return err
+	}
+	encryptedShareT, err := symmetricKey.Encrypt(shareT.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return evidenceLog.PutPeerSharesMessage(&PeerSharesMessage{
+		senderID: senderID,
+		shares: map[group.MemberIndex]*peerShares{
+			receiverID: {encryptedShareS, encryptedShareT},
+		},
+	})
+}
+
 func findSharesJustifyingMemberByID(
 	members []*SharesJustifyingMember,
 	id group.MemberIndex,
@@ -642,3 +853,124 @@ func initializePointsJustifyingMemberGroup(
 
 	return pointsJustifyingMembers, nil
 }
+
+func TestDisqualificationRecords(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializePointsJustifyingMemberGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := members[0]
+
+	// Phase 2: member 2 sends ephemeral public key message without keys.
+	err = member.GenerateSymmetricKeys([]*EphemeralPublicKeyMessage{
+		{
+			senderID:            2,
+			ephemeralPublicKeys: make(map[group.MemberIndex]*ephemeral.PublicKey),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Phase 5: member 3 accuses the current member.
+	err = member.ResolveSecretSharesAccusationsMessages(
+		[]*SecretSharesAccusationsMessage{
+			{
+				senderID: 3,
+				accusedMembersKeys: map[group.MemberIndex]*ephemeral.PrivateKey{
+					member.ID: nil,
+				},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Phase 8: member 4 sends no public key share points.
+	_, err = member.VerifyPublicKeySharePoints(
+		[]*MemberPublicKeySharePointsMessage{
+			{senderID: 4},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Phase 9: already disqualified member 2 accuses the current member;
+	// the member is not disqualified again.
+	err = member.ResolvePublicKeySharePointsAccusationsMessages(
+		[]*PointsAccusationsMessage{
+			{
+				senderID: 2,
+				accusedMembersKeys: map[group.MemberIndex]*ephemeral.PrivateKey{
+					member.ID: nil,
+				},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedRecords := []struct {
+		memberID group.MemberIndex
+		phase    int
+	}{
+		{2, 2},
+		{3, 5},
+		{4, 8},
+	}
+
+	finalizingMember := member.InitializeRevealing().
+		InitializeReconstruction().
+		InitializeCombining().
+		InitializeFinalization()
+	finalizingMember.groupPrivateKeyShare = big.NewInt(1)
+
+	records := finalizingMember.Result().Disqualifications
+	if len(records) != len(expectedRecords) {
+		t.Fatalf(
+			"unexpected number of disqualification records\n"+
+				"expected: %v\nactual:   %v",
+			len(expectedRecords),
+			len(records),
+		)
+	}
+
+	for i, expected := range expectedRecords {
+		record := records[i]
+		if record.MemberID != expected.memberID || record.Phase != expected.phase {
+			t.Errorf(
+				"unexpected disqualification record [%v]\n"+
+					"expected: member [%v] in phase [%v]\n"+
+					"actual:   member [%v] in phase [%v]",
+				i,
+				expected.memberID,
+				expected.phase,
+				record.MemberID,
+				record.Phase,
+			)
+		}
+		if record.Reason == "" {
+			t.Errorf("missing reason of disqualification record [%v]", i)
+		}
+	}
+
+	if !reflect.DeepEqual(
+		[]group.MemberIndex{2, 3, 4},
+		member.group.DisqualifiedMemberIDs(),
+	) {
+		t.Errorf(
+			"unexpected disqualified members [%v]",
+			member.group.DisqualifiedMemberIDs(),
+		)
+	}
+}
@@ -3,6 +3,7 @@ package gjkr
 import (
 	"fmt"
 	"math/big"
+	"reflect"
 	"testing"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
@@ -64,6 +65,49 @@ func TestCombineGroupPublicKey(t *testing.T) {
 	}
 }
 
+func TestReceivedValidPeerIndividualPublicKeysOrder(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializeCombiningMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	member := members[0]
+
+	member.receivedValidPeerPublicKeySharePoints = make(
+		map[group.MemberIndex][]*bn256.G2,
+	)
+	for _, senderID := range []group.MemberIndex{5, 2, 4, 3} {
+		member.receivedValidPeerPublicKeySharePoints[senderID] = []*bn256.G2{
+			new(bn256.G2).ScalarBaseMult(big.NewInt(int64(senderID) * 10)),
+		}
+	}
+
+	var expectedPublicKeys []string
+	for _, senderID := range []int64{2, 3, 4, 5} {
+		expectedPublicKeys = append(
+			expectedPublicKeys,
+			new(bn256.G2).ScalarBaseMult(big.NewInt(senderID*10)).String(),
+		)
+	}
+
+	for i := 0; i < 10; i++ {
+		var actualPublicKeys []string
+		for _, publicKey := range member.receivedValidPeerIndividualPublicKeys() {
+			actualPublicKeys = append(actualPublicKeys, publicKey.String())
+		}
+
+		if !reflect.DeepEqual(expectedPublicKeys, actualPublicKeys) {
+			t.Fatalf(
+				"unexpected individual public keys order\nexpected: %v\nactual:   %v\n",
+				expectedPublicKeys,
+				actualPublicKeys,
+			)
+		}
+	}
+}
+
 func TestCombineGroupPublicKeyShares(t *testing.T) {
 	dishonestThreshold := 1
 	groupSize := 3
@@ -1,6 +1,7 @@
 package gjkr
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -209,6 +210,339 @@ func TestSharesAndCommitmentsCalculationAndVerification(t *testing.T) {
 	}
 }
 
+func TestSharesAndCommitmentsVerificationForLargerGroup(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 3
+
+	var tests = map[string]struct {
+		modifyPeerSharesMessage  func(message *PeerSharesMessage, symmetricKey ephemeral.SymmetricKey) error
+		modifyCommitmentsMessage func(message *MemberCommitmentsMessage)
+	}{
+		"shares for member outside of the group": {
+			modifyPeerSharesMessage: func(
+				message *PeerSharesMessage,
+				symmetricKey ephemeral.SymmetricKey,
+			) error {
+				return message.addShares(
+					group.MemberIndex(groupSize+1),
+					big.NewInt(1),
+					big.NewInt(2),
+					symmetricKey,
+				)
+			},
+		},
+		"shares for the sender": {
+			modifyPeerSharesMessage: func(
+				message *PeerSharesMessage,
+				symmetricKey ephemeral.SymmetricKey,
+			) error {
+				return message.addShares(
+					message.senderID,
+					big.NewInt(1),
+					big.NewInt(2),
+					symmetricKey,
+				)
+			},
+		},
+		"commitments for a higher dishonest threshold": {
+			modifyCommitmentsMessage: func(message *MemberCommitmentsMessage) {
+				message.commitments = append(
+					message.commitments,
+					new(bn256.G1).ScalarBaseMult(big.NewInt(1)),
+				)
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeCommittingMembersGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatalf("group initialization failed [%s]", err)
+			}
+
+			member1 := members[0]
+			member2 := members[1]
+			member3 := members[2]
+
+			var sharesMessages []*PeerSharesMessage
+			var commitmentsMessages []*MemberCommitmentsMessage
+			for _, member := range []*CommittingMember{member1, member2} {
+				shares, commitments, err := member.CalculateMembersSharesAndCommitments()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				sharesMessages = append(sharesMessages, shares)
+				commitmentsMessages = append(commitmentsMessages, commitments)
+			}
+
+			if test.modifyPeerSharesMessage != nil {
+				err := test.modifyPeerSharesMessage(
+					sharesMessages[1],
+					member3.symmetricKeys[member2.ID],
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if test.modifyCommitmentsMessage != nil {
+				test.modifyCommitmentsMessage(commitmentsMessages[1])
+			}
+
+			verifyingMember := member3.InitializeCommitmentsVerification()
+
+			accusationMessage, err := verifyingMember.VerifyReceivedSharesAndCommitmentsMessages(
+				sharesMessages,
+				commitmentsMessages,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(accusationMessage.accusedMembersKeys) != 0 {
+				t.Errorf(
+					"unexpected accusations\nexpected: %v\nactual:   %v\n",
+					0,
+					len(accusationMessage.accusedMembersKeys),
+				)
+			}
+
+			expectedDisqualified := []group.MemberIndex{member2.ID}
+			disqualified := verifyingMember.group.DisqualifiedMemberIDs()
+			if !reflect.DeepEqual(expectedDisqualified, disqualified) {
+				t.Errorf(
+					"unexpected disqualified members\nexpected: %v\nactual:   %v\n",
+					expectedDisqualified,
+					disqualified,
+				)
+			}
+
+			if _, ok := verifyingMember.receivedQualifiedSharesS[member2.ID]; ok {
+				t.Errorf("unexpected shares accepted from member [%v]", member2.ID)
+			}
+			if _, ok := verifyingMember.receivedQualifiedSharesS[member1.ID]; !ok {
+				t.Errorf("expected shares accepted from member [%v]", member1.ID)
+			}
+		})
+	}
+}
+
+func TestSharesAndCommitmentsVerificationWithAccusationsLimit(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 6
+
+	// Commitments of all members except member 5 are corrupted so that
+	// the verifying member 6 accuses members 1, 2, 3 and 4.
+	corruptedMembers := []group.MemberIndex{1, 2, 3, 4}
+
+	var tests = map[string]struct {
+		maxAccusations     int
+		expectedAccusedIDs []group.MemberIndex
+	}{
+		"limit disabled": {
+			maxAccusations:     0,
+			expectedAccusedIDs: []group.MemberIndex{1, 2, 3, 4},
+		},
+		"limit not reached": {
+			maxAccusations:     5,
+			expectedAccusedIDs: []group.MemberIndex{1, 2, 3, 4},
+		},
+		"limit reached": {
+			maxAccusations:     2,
+			expectedAccusedIDs: []group.MemberIndex{1, 2},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeCommittingMembersGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatalf("group initialization failed [%s]", err)
+			}
+
+			var sharesMessages []*PeerSharesMessage
+			var commitmentsMessages []*MemberCommitmentsMessage
+			for _, member := range members[:groupSize-1] {
+				shares, commitments, err := member.CalculateMembersSharesAndCommitments()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if contains(corruptedMembers, member.ID) {
+					commitments.commitments[0] = new(bn256.G1).ScalarMult(
+						commitments.commitments[0],
+						big.NewInt(3),
+					)
+				}
+
+				sharesMessages = append(sharesMessages, shares)
+				commitmentsMessages = append(commitmentsMessages, commitments)
+			}
+
+			member := members[groupSize-1]
+			if err := member.LimitSharesAccusations(test.maxAccusations); err != nil {
+				t.Fatal(err)
+			}
+
+			verifyingMember := member.InitializeCommitmentsVerification()
+
+			accusationMessage, err := verifyingMember.VerifyReceivedSharesAndCommitmentsMessages(
+				sharesMessages,
+				commitmentsMessages,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertAccusedMembers(
+				test.expectedAccusedIDs,
+				verifyingMember,
+				accusationMessage,
+				t,
+			)
+		})
+	}
+}
+
+func TestLimitSharesAccusationsInvalidLimit(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializeCommittingMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	if err != nil {
+		t.Fatalf("group initialization failed [%s]", err)
+	}
+
+	err = members[0].LimitSharesAccusations(dishonestThreshold)
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			ErrInvalidConfig,
+			err,
+		)
+	}
+}
+
+func TestSharesAndCommitmentsVerificationWithPolynomialDegree(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 5
+	polynomialDegree := 3
+
+	members, err := initializeCommittingMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	if err != nil {
+		t.Fatalf("group initialization failed [%s]", err)
+	}
+
+	var sharesMessages []*PeerSharesMessage
+	var commitmentsMessages []*MemberCommitmentsMessage
+	for _, member := range members {
+		if err := member.SetPolynomialDegree(polynomialDegree); err != nil {
+			t.Fatal(err)
+		}
+
+		shares, commitments, err := member.CalculateMembersSharesAndCommitments()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(member.secretCoefficients) != polynomialDegree+1 {
+			t.Errorf(
+				"unexpected number of secret coefficients"+
+					"\nexpected: %v\nactual:   %v\n",
+				polynomialDegree+1,
+				len(member.secretCoefficients),
+			)
+		}
+		if len(commitments.commitments) != polynomialDegree+1 {
+			t.Errorf(
+				"unexpected number of commitments"+
+					"\nexpected: %v\nactual:   %v\n",
+				polynomialDegree+1,
+				len(commitments.commitments),
+			)
+		}
+
+		if member.ID != members[0].ID {
+			sharesMessages = append(sharesMessages, shares)
+			commitmentsMessages = append(commitmentsMessages, commitments)
+		}
+	}
+
+	verifyingMember := members[0].InitializeCommitmentsVerification()
+
+	accusationMessage, err := verifyingMember.VerifyReceivedSharesAndCommitmentsMessages(
+		sharesMessages,
+		commitmentsMessages,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedAccusedIDs := []group.MemberIndex{}
+	assertAccusedMembers(
+		expectedAccusedIDs,
+		verifyingMember,
+		accusationMessage,
+		t,
+	)
+	assertValidSharesAndCommitments(
+		expectedAccusedIDs,
+		verifyingMember,
+		groupSize,
+		t,
+	)
+}
+
+func TestSetPolynomialDegreeInvalidDegree(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 5
+
+	members, err := initializeCommittingMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+	if err != nil {
+		t.Fatalf("group initialization failed [%s]", err)
+	}
+
+	var tests = map[string]struct {
+		polynomialDegree int
+	}{
+		"degree below dishonest threshold": {
+			polynomialDegree: dishonestThreshold - 1,
+		},
+		"degree not reconstructable by honest members": {
+			polynomialDegree: groupSize - dishonestThreshold,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := members[0].SetPolynomialDegree(test.polynomialDegree)
+			if !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					ErrInvalidConfig,
+					err,
+				)
+			}
+		})
+	}
+}
+
 func alterPeerSharesMessage(
 	message *PeerSharesMessage,
 	receiverID group.MemberIndex,
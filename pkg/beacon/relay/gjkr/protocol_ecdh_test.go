@@ -1,6 +1,8 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -138,6 +140,162 @@ func TestGenerateEphemeralKeys(t *testing.T) {
 	}
 }
 
+func TestGenerateEphemeralKeysUnregisteredMember(t *testing.T) {
+	groupSize := 5
+	dishonestThreshold := 2
+
+	member := initializeEphemeralKeyPairMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)[0]
+
+	// Group members are 1..5 so member 6 is not registered in the group.
+	member.ID = group.MemberIndex(groupSize + 1)
+
+	message, err := member.GenerateEphemeralKeyPair()
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			ErrInvalidConfig,
+			err,
+		)
+	}
+	if message != nil {
+		t.Errorf("unexpected message [%v]", message)
+	}
+	if len(member.ephemeralKeyPairs) != 0 {
+		t.Errorf(
+			"expected no ephemeral key pairs; has [%v]",
+			len(member.ephemeralKeyPairs),
+		)
+	}
+}
+
+func TestGenerateEphemeralKeysFailure(t *testing.T) {
+	groupSize := 5
+	dishonestThreshold := 2
+
+	member := initializeEphemeralKeyPairMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)[0]
+
+	// Each key pair is generated from a single read, so the third key pair
+	// fails to be generated.
+	randomSource := &failingReader{failOnRead: 3}
+	member.randomSource = randomSource
+
+	message, err := member.GenerateEphemeralKeyPair()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if message != nil {
+		t.Errorf("unexpected message [%v]", message)
+	}
+	if randomSource.reads != 3 {
+		t.Fatalf("unexpected number of reads [%v]", randomSource.reads)
+	}
+
+	if len(member.ephemeralKeyPairs) != 0 {
+		t.Fatalf(
+			"expected no ephemeral key pairs; has [%v]",
+			len(member.ephemeralKeyPairs),
+		)
+	}
+
+	// Once the source of randomness works again, a complete set of keys
+	// is generated.
+	member.randomSource = crand.Reader
+
+	message, err = member.GenerateEphemeralKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(member.ephemeralKeyPairs) != groupSize-1 {
+		t.Fatalf(
+			"unexpected number of ephemeral key pairs\nexpected: %v\nactual:   %v",
+			groupSize-1,
+			len(member.ephemeralKeyPairs),
+		)
+	}
+	for memberID, publicKey := range message.ephemeralPublicKeys {
+		if member.ephemeralKeyPairs[memberID].PublicKey != publicKey {
+			t.Errorf("unexpected public key for member [%v]", memberID)
+		}
+	}
+}
+
+// failingReader is a source of randomness failing on the given read.
+type failingReader struct {
+	failOnRead int
+	reads      int
+}
+
+func (fr *failingReader) Read(p []byte) (int, error) {
+	fr.reads++
+	if fr.reads >= fr.failOnRead {
+		return 0, fmt.Errorf("random source failure")
+	}
+	return crand.Read(p)
+}
+
+func TestGenerateSymmetricKeysForLargerGroup(t *testing.T) {
+	groupSize := 3
+	dishonestThreshold := 1
+
+	ephemeralGeneratingMembers := initializeEphemeralKeyPairMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+
+	var messages []*EphemeralPublicKeyMessage
+	for _, member := range ephemeralGeneratingMembers[1:] {
+		message, err := member.GenerateEphemeralKeyPair()
+		if err != nil {
+			t.Fatal(err)
+		}
+		messages = append(messages, message)
+	}
+
+	// Member 2 sends a public key for a member outside of the group, as if
+	// it was working with a larger group.
+	keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages[0].ephemeralPublicKeys[group.MemberIndex(groupSize+1)] =
+		keyPair.PublicKey
+
+	member1 := ephemeralGeneratingMembers[0]
+	if _, err := member1.GenerateEphemeralKeyPair(); err != nil {
+		t.Fatal(err)
+	}
+
+	symmetricGeneratingMember := member1.InitializeSymmetricKeyGeneration()
+	err = symmetricGeneratingMember.GenerateSymmetricKeys(messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedDisqualified := []group.MemberIndex{2}
+	disqualified := symmetricGeneratingMember.group.DisqualifiedMemberIDs()
+	if !reflect.DeepEqual(expectedDisqualified, disqualified) {
+		t.Errorf(
+			"unexpected disqualified members\nexpected: %v\nactual:   %v\n",
+			expectedDisqualified,
+			disqualified,
+		)
+	}
+
+	if _, ok := symmetricGeneratingMember.symmetricKeys[2]; ok {
+		t.Errorf("unexpected symmetric key established with member [2]")
+	}
+	if _, ok := symmetricGeneratingMember.symmetricKeys[3]; !ok {
+		t.Errorf("expected symmetric key established with member [3]")
+	}
+}
+
 func initializeEphemeralKeyPairMembersGroup(
 	dishonestThreshold int,
 	groupSize int,
@@ -156,6 +314,7 @@ func initializeEphemeralKeyPairMembersGroup(
 					group:              dkgGroup,
 					evidenceLog:        newDkgEvidenceLog(),
 					protocolParameters: protocolParameters,
+					randomSource:       crand.Reader,
 				},
 			},
 			ephemeralKeyPairs: make(map[group.MemberIndex]*ephemeral.KeyPair),
@@ -176,7 +335,7 @@ func initializeSymmetricKeyMembersGroup(
 		for _, member2 := range keyPairMembers {
 			if member1.ID != member2.ID {
 
-				keyPair, err := ephemeral.GenerateKeyPair()
+				keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
 				if err != nil {
 					return nil, fmt.Errorf(
 						"SymmetricKeyGeneratingMember initialization failed [%v]",
@@ -2,6 +2,7 @@ package gjkr
 
 import (
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/keep-network/keep-core/pkg/altbn128"
@@ -10,17 +11,87 @@ import (
 // protocolParameters holds all cryptographic parameters that must be the same
 // for all members in the group.
 type protocolParameters struct {
+	// Seed the parameters were created from. It is unique for each key
+	// generation, so it binds signed data to the key generation session.
+	seed *big.Int
+
 	// `H = G*a` is a custom generator where `a` is unknown. It is used to
 	// produce Pedersen commitments.
 	H *bn256.G1
+
+	// Precomputed tables of multiples of `G` and `H` used to speed up
+	// computation of commitments. Nil if the member does not use
+	// precomputed generators.
+	gTable *altbn128.G1Table
+	hTable *altbn128.G1Table
 }
 
+var (
+	precomputationMutex sync.Mutex
+	// Table for `G` does not depend on the seed so it is shared by all
+	// protocol parameters.
+	precomputedGTable *altbn128.G1Table
+	// The most recently created parameters with precomputed tables. Members
+	// executing the protocol with the same seed share them.
+	precomputedSeed       *big.Int
+	precomputedParameters *protocolParameters
+)
+
 // newProtocolParameters creates a new instance of protocolParameters from the
 // provided seed value which can be the previous random beacon's result.
 // The seed is used to evaluate `H` parameter so that the discrete logarithm of
 // `H` is unknown.
 func newProtocolParameters(seed *big.Int) *protocolParameters {
 	return &protocolParameters{
-		H: altbn128.G1HashToPoint(seed.Bytes()),
+		seed: new(big.Int).Set(seed),
+		H:    altbn128.G1HashToPoint(seed.Bytes()),
+	}
+}
+
+// newPrecomputedProtocolParameters creates a new instance of
+// protocolParameters from the provided seed value, just like
+// newProtocolParameters, along with tables of multiples of generators `G` and
+// `H`. Members executing the protocol in the same process with the same seed
+// share the precomputed tables.
+func newPrecomputedProtocolParameters(seed *big.Int) *protocolParameters {
+	precomputationMutex.Lock()
+	defer precomputationMutex.Unlock()
+
+	if precomputedSeed != nil && precomputedSeed.Cmp(seed) == 0 {
+		return precomputedParameters
+	}
+
+	if precomputedGTable == nil {
+		precomputedGTable = altbn128.NewG1Table(
+			new(bn256.G1).ScalarBaseMult(big.NewInt(1)),
+		)
+	}
+
+	H := altbn128.G1HashToPoint(seed.Bytes())
+
+	precomputedSeed = new(big.Int).Set(seed)
+	precomputedParameters = &protocolParameters{
+		seed:   precomputedSeed,
+		H:      H,
+		gTable: precomputedGTable,
+		hTable: altbn128.NewG1Table(H),
+	}
+
+	return precomputedParameters
+}
+
+// multiplyG returns `G * k`.
+func (pp *protocolParameters) multiplyG(k *big.Int) *bn256.G1 {
+	if pp.gTable != nil {
+		return pp.gTable.ScalarMult(k)
+	}
+	return new(bn256.G1).ScalarBaseMult(k)
+}
+
+// multiplyH returns `H * k`.
+func (pp *protocolParameters) multiplyH(k *big.Int) *bn256.G1 {
+	if pp.hTable != nil {
+		return pp.hTable.ScalarMult(k)
 	}
+	return new(bn256.G1).ScalarMult(pp.H, k)
 }
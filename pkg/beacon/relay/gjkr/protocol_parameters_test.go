@@ -0,0 +1,138 @@
+package gjkr
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestPrecomputedGeneratorsCommitments(t *testing.T) {
+	seed := big.NewInt(1410)
+
+	member := &CommittingMember{
+		SymmetricKeyGeneratingMember: &SymmetricKeyGeneratingMember{
+			EphemeralKeyPairGeneratingMember: &EphemeralKeyPairGeneratingMember{
+				LocalMember: &LocalMember{
+					memberCore: &memberCore{
+						protocolParameters: newProtocolParameters(seed),
+					},
+				},
+			},
+		},
+	}
+
+	precomputedParameters := newPrecomputedProtocolParameters(seed)
+	if precomputedParameters.gTable == nil || precomputedParameters.hTable == nil {
+		t.Fatal("expected precomputed tables")
+	}
+	if newPrecomputedProtocolParameters(seed) != precomputedParameters {
+		t.Fatal("expected precomputed parameters to be shared for the same seed")
+	}
+
+	precomputedMember := &CommittingMember{
+		SymmetricKeyGeneratingMember: &SymmetricKeyGeneratingMember{
+			EphemeralKeyPairGeneratingMember: &EphemeralKeyPairGeneratingMember{
+				LocalMember: &LocalMember{
+					memberCore: &memberCore{
+						protocolParameters: precomputedParameters,
+					},
+				},
+			},
+		},
+	}
+
+	coefficientsA, err := generatePolynomial(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coefficientsB, err := generatePolynomial(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Precomputed tables are read concurrently by all members sharing them.
+	var wg sync.WaitGroup
+	for k := range coefficientsA {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+
+			expected := member.calculateCommitment(
+				coefficientsA[k],
+				coefficientsB[k],
+			)
+			actual := precomputedMember.calculateCommitment(
+				coefficientsA[k],
+				coefficientsB[k],
+			)
+
+			if expected.String() != actual.String() {
+				t.Errorf(
+					"unexpected commitment [%v]\nexpected: %v\nactual:   %v",
+					k,
+					expected,
+					actual,
+				)
+			}
+		}(k)
+	}
+	wg.Wait()
+}
+
+func BenchmarkCalculateCommitments(b *testing.B) {
+	seed := big.NewInt(1410)
+
+	for _, precompute := range []bool{false, true} {
+		for _, groupSize := range []int{10, 50, 100} {
+			dishonestThreshold := groupSize / 2
+
+			b.Run(
+				fmt.Sprintf(
+					"groupSize=%v,threshold=%v,precompute=%v",
+					groupSize,
+					dishonestThreshold,
+					precompute,
+				),
+				func(b *testing.B) {
+					parameters := newProtocolParameters(seed)
+					if precompute {
+						parameters = newPrecomputedProtocolParameters(seed)
+					}
+
+					member := &CommittingMember{
+						SymmetricKeyGeneratingMember: &SymmetricKeyGeneratingMember{
+							EphemeralKeyPairGeneratingMember: &EphemeralKeyPairGeneratingMember{
+								LocalMember: &LocalMember{
+									memberCore: &memberCore{
+										protocolParameters: parameters,
+									},
+								},
+							},
+						},
+					}
+
+					coefficientsA, err := generatePolynomial(dishonestThreshold)
+					if err != nil {
+						b.Fatal(err)
+					}
+					coefficientsB, err := generatePolynomial(dishonestThreshold)
+					if err != nil {
+						b.Fatal(err)
+					}
+
+					b.ResetTimer()
+
+					for i := 0; i < b.N; i++ {
+						for k := range coefficientsA {
+							member.calculateCommitment(
+								coefficientsA[k],
+								coefficientsB[k],
+							)
+						}
+					}
+				},
+			)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -88,7 +90,7 @@ func TestRevealMisbehavedMembersShares(t *testing.T) {
 	clearedMember := member5
 	for _, message := range misbehavedEphemeralKeysMessages {
 		if message.senderID == invalidRevealingMember.ID {
-			newKeyPair, err := ephemeral.GenerateKeyPair()
+			newKeyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -248,7 +250,9 @@ func TestReconstructIndividualPrivateKeys(t *testing.T) {
 
 	for _, m := range group {
 		if !contains(disqualifiedMembersIDs, m.ID) {
-			m.reconstructIndividualPrivateKeys(allDisqualifiedShares)
+			if err := m.reconstructIndividualPrivateKeys(allDisqualifiedShares); err != nil {
+				t.Fatal(err)
+			}
 
 			if m.reconstructedIndividualPrivateKeys[disqualifiedMember1.ID].Cmp(expectedIndividualPrivateKey1) != 0 {
 				t.Fatalf("invalid reconstructed private key 1\nexpected: %s\nactual:   %s\n",
@@ -267,6 +271,100 @@ func TestReconstructIndividualPrivateKeys(t *testing.T) {
 	}
 }
 
+func TestReconstructIndividualPrivateKeysFromThresholdShares(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 7
+
+	disqualifiedMemberID := group.MemberIndex(3)
+
+	var tests = map[string]struct {
+		modifyShares  func(shares map[group.MemberIndex]*big.Int)
+		expectedError error
+	}{
+		"exactly threshold shares": {
+			modifyShares: func(shares map[group.MemberIndex]*big.Int) {
+				// leave shares of members 1, 2 and 4
+				delete(shares, 5)
+				delete(shares, 6)
+				delete(shares, 7)
+			},
+		},
+		"extra shares": {
+			modifyShares: func(shares map[group.MemberIndex]*big.Int) {},
+		},
+		"fewer than threshold shares": {
+			modifyShares: func(shares map[group.MemberIndex]*big.Int) {
+				// leave shares of members 1 and 2
+				delete(shares, 4)
+				delete(shares, 5)
+				delete(shares, 6)
+				delete(shares, 7)
+			},
+			expectedError: ErrInsufficientShares,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			members, err := initializeReconstructingMembersGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reconstructingMember := members[0]
+			disqualifiedMember := members[disqualifiedMemberID-1]
+
+			// polynomial's zeroth coefficient is member's individual private key
+			expectedIndividualPrivateKey := disqualifiedMember.individualPrivateKey()
+
+			reconstructingMember.group.MarkMemberAsDisqualified(disqualifiedMemberID)
+
+			allDisqualifiedShares := disqualifyMembers(
+				members,
+				[]group.MemberIndex{disqualifiedMemberID},
+			)
+			test.modifyShares(allDisqualifiedShares[0].peerSharesS)
+
+			err = reconstructingMember.reconstructIndividualPrivateKeys(
+				allDisqualifiedShares,
+			)
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+			if test.expectedError != nil {
+				return
+			}
+
+			reconstructedIndividualPrivateKey :=
+				reconstructingMember.reconstructedIndividualPrivateKeys[disqualifiedMemberID]
+			if reconstructedIndividualPrivateKey.Cmp(expectedIndividualPrivateKey) != 0 {
+				t.Errorf(
+					"invalid reconstructed private key\nexpected: %s\nactual:   %s\n",
+					expectedIndividualPrivateKey,
+					reconstructedIndividualPrivateKey,
+				)
+			}
+
+			expectedDisqualifiedMembers := []group.MemberIndex{disqualifiedMemberID}
+			disqualifiedMembers := reconstructingMember.group.DisqualifiedMemberIDs()
+			if !reflect.DeepEqual(expectedDisqualifiedMembers, disqualifiedMembers) {
+				t.Errorf(
+					"unexpected disqualified members\nexpected: %v\nactual:   %v\n",
+					expectedDisqualifiedMembers,
+					disqualifiedMembers,
+				)
+			}
+		})
+	}
+}
+
 func contains(slice []group.MemberIndex, value group.MemberIndex) bool {
 	for _, i := range slice {
 		if i == value {
@@ -325,6 +423,168 @@ func TestCalculateReconstructedIndividualPublicKeys(t *testing.T) {
 	}
 }
 
+func TestReconstructedIndividualPublicKeysCrossCheck(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 4
+
+	misbehavedMemberID := group.MemberIndex(4)
+
+	var tests = map[string]struct {
+		tamperedPointIndex  int
+		expectedErrorsCount int
+	}{
+		"individual public key matching reconstructed one": {
+			tamperedPointIndex:  1,
+			expectedErrorsCount: 0,
+		},
+		"individual public key not matching reconstructed one": {
+			tamperedPointIndex:  0,
+			expectedErrorsCount: 1,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			committingMembers, err := initializeCommittingMembersGroup(
+				dishonestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Phase 3
+			var sharesMessages []*PeerSharesMessage
+			var commitmentsMessages []*MemberCommitmentsMessage
+			for _, member := range committingMembers {
+				sharesMessage, commitmentsMessage, err :=
+					member.CalculateMembersSharesAndCommitments()
+				if err != nil {
+					t.Fatal(err)
+				}
+				sharesMessages = append(sharesMessages, sharesMessage)
+				commitmentsMessages = append(commitmentsMessages, commitmentsMessage)
+			}
+
+			// Phases 4, 5 and 6
+			var sharingMembers []*SharingMember
+			for _, committingMember := range committingMembers {
+				member := committingMember.InitializeCommitmentsVerification()
+				if _, err := member.VerifyReceivedSharesAndCommitmentsMessages(
+					filterPeerSharesMessage(sharesMessages, member.ID),
+					filterMemberCommitmentsMessages(commitmentsMessages, member.ID),
+				); err != nil {
+					t.Fatal(err)
+				}
+
+				qualifiedMember := member.InitializeSharesJustification().
+					InitializeQualified()
+				qualifiedMember.CombineMemberShares()
+				sharingMembers = append(
+					sharingMembers,
+					qualifiedMember.InitializeSharing(),
+				)
+			}
+
+			// Phase 7: the misbehaved member broadcasts public key share
+			// points inconsistent with its shares.
+			pointsMessages := make(
+				[]*MemberPublicKeySharePointsMessage,
+				len(sharingMembers),
+			)
+			for i, member := range sharingMembers {
+				pointsMessages[i] = member.CalculatePublicKeySharePoints()
+			}
+			tamperedPoints := append(
+				[]*bn256.G2{},
+				pointsMessages[misbehavedMemberID-1].publicKeySharePoints...,
+			)
+			tamperedPoints[test.tamperedPointIndex] = new(bn256.G2).Add(
+				tamperedPoints[test.tamperedPointIndex],
+				new(bn256.G2).ScalarBaseMult(big.NewInt(1)),
+			)
+			pointsMessages[misbehavedMemberID-1].publicKeySharePoints = tamperedPoints
+
+			// Phases 8, 9 and 10
+			var revealingMembers []*RevealingMember
+			var revealedKeysMessages []*MisbehavedEphemeralKeysMessage
+			for _, member := range sharingMembers {
+				if member.ID == misbehavedMemberID {
+					continue
+				}
+
+				if _, err := member.VerifyPublicKeySharePoints(
+					filterMemberPublicKeySharePointsMessages(
+						pointsMessages,
+						member.ID,
+					),
+				); err != nil {
+					t.Fatal(err)
+				}
+
+				revealingMember := member.InitializePointsJustification().
+					InitializeRevealing()
+				message, err := revealingMember.RevealMisbehavedMembersKeys()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				revealingMembers = append(revealingMembers, revealingMember)
+				revealedKeysMessages = append(revealedKeysMessages, message)
+			}
+
+			// Phase 11
+			member := revealingMembers[0].InitializeReconstruction()
+
+			var peerMessages []*MisbehavedEphemeralKeysMessage
+			for _, message := range revealedKeysMessages {
+				if message.senderID != member.ID {
+					peerMessages = append(peerMessages, message)
+				}
+			}
+
+			if err := member.ReconstructMisbehavedIndividualKeys(
+				peerMessages,
+			); err != nil {
+				t.Fatal(err)
+			}
+
+			misbehavedMember := committingMembers[misbehavedMemberID-1]
+			expectedPublicKey := new(bn256.G2).ScalarBaseMult(
+				misbehavedMember.secretCoefficients[0],
+			)
+			actualPublicKey := member.reconstructedIndividualPublicKeys[misbehavedMemberID]
+			if actualPublicKey.String() != expectedPublicKey.String() {
+				t.Errorf(
+					"unexpected individual public key of misbehaved member"+
+						"\nexpected: %v\nactual:   %v\n",
+					expectedPublicKey,
+					actualPublicKey,
+				)
+			}
+
+			if len(member.reconstructionErrors) != test.expectedErrorsCount {
+				t.Fatalf(
+					"unexpected number of reconstruction errors"+
+						"\nexpected: %v\nactual:   %v\n",
+					test.expectedErrorsCount,
+					len(member.reconstructionErrors),
+				)
+			}
+			for _, err := range member.reconstructionErrors {
+				if !errors.Is(err, ErrVerificationFailed) {
+					t.Errorf(
+						"unexpected reconstruction error"+
+							"\nexpected: %v\nactual:   %v\n",
+						ErrVerificationFailed,
+						err,
+					)
+				}
+			}
+		})
+	}
+}
+
 func TestReconstructMisbehavedIndividualKeys(t *testing.T) {
 	dishonestThreshold := 2
 	groupSize := 6
@@ -401,6 +661,10 @@ func TestReconstructMisbehavedIndividualKeys(t *testing.T) {
 
 	member1.ReconstructMisbehavedIndividualKeys(misbehavedEphemeralKeysMessages)
 
+	if member1.receivedPeerCommitments != nil {
+		t.Errorf("received peer commitments not released after reconstruction")
+	}
+
 	for _, disqualifiedMember := range disqualifiedMembers {
 		if disqualifiedMember.individualPrivateKey().
 			Cmp(member1.reconstructedIndividualPrivateKeys[disqualifiedMember.ID]) != 0 {
@@ -50,6 +50,78 @@ func TestCombineReceivedShares(t *testing.T) {
 	}
 }
 
+func TestCombineMemberSharesReleasesSharesJustificationData(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializeQualifiedMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatalf("group initialization failed [%s]", err)
+	}
+
+	member := members[0]
+	member.selfSecretShareS = big.NewInt(9)
+
+	// Simulate member 5 disqualified in phase 5.
+	disqualifiedMemberID := group.MemberIndex(5)
+	member.discardReceivedShares(disqualifiedMemberID)
+
+	sharesT := make([]*big.Int, 0, len(member.receivedQualifiedSharesT))
+	for _, shareT := range member.receivedQualifiedSharesT {
+		sharesT = append(sharesT, shareT)
+	}
+	if len(sharesT) != groupSize-2 {
+		t.Fatalf(
+			"unexpected number of received shares T\nexpected: %v\nactual:   %v",
+			groupSize-2,
+			len(sharesT),
+		)
+	}
+
+	member.CombineMemberShares()
+
+	if member.receivedQualifiedSharesT != nil {
+		t.Errorf("received shares T not released")
+	}
+	for _, shareT := range sharesT {
+		if shareT.Sign() != 0 {
+			t.Errorf("released share T not wiped: [%v]", shareT)
+		}
+	}
+
+	if _, ok := member.receivedPeerCommitments[disqualifiedMemberID]; ok {
+		t.Errorf(
+			"commitments of member [%v] outside of QUAL not released",
+			disqualifiedMemberID,
+		)
+	}
+	for memberID := range member.receivedQualifiedSharesS {
+		if _, ok := member.receivedPeerCommitments[memberID]; !ok {
+			t.Errorf("commitments of QUAL member [%v] released", memberID)
+		}
+	}
+
+	// Later phases use only the values kept.
+	sharingMember := member.InitializeSharing()
+	sharingMember.CalculatePublicKeySharePoints()
+
+	revealingMember := sharingMember.InitializePointsJustification().
+		InitializeRevealing()
+	revealingMember.group.MarkMemberAsDisqualified(4)
+	delete(revealingMember.receivedValidPeerPublicKeySharePoints, 4)
+
+	message, err := revealingMember.RevealMisbehavedMembersKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := message.privateKeys[4]; !ok {
+		t.Errorf("expected key of member [4] to be revealed")
+	}
+	if _, ok := revealingMember.receivedPeerCommitments[4]; !ok {
+		t.Errorf("commitments of member [4] needed for reconstruction released")
+	}
+}
+
 func TestCalculatePublicKeySharePoints(t *testing.T) {
 	secretCoefficients := []*big.Int{
 		big.NewInt(3),
@@ -184,6 +256,113 @@ func TestCalculateAndVerifyPublicKeySharePoints(t *testing.T) {
 	}
 }
 
+func TestEvaluatePolynomial(t *testing.T) {
+	// 3 + 2x + 5x^2 mod 7
+	coefficients := []*big.Int{big.NewInt(3), big.NewInt(2), big.NewInt(5)}
+	q := big.NewInt(7)
+
+	var tests = map[string]struct {
+		x              int64
+		expectedResult int64
+	}{
+		"at zero": {
+			x:              0,
+			expectedResult: 3,
+		},
+		"at one": {
+			x:              1,
+			expectedResult: 3, // 10 mod 7
+		},
+		"at two": {
+			x:              2,
+			expectedResult: 6, // 27 mod 7
+		},
+		"at modulus": {
+			x:              7,
+			expectedResult: 3,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			result := evaluatePolynomial(coefficients, big.NewInt(test.x), q)
+			if result.Cmp(big.NewInt(test.expectedResult)) != 0 {
+				t.Errorf(
+					"unexpected result\nexpected: %v\nactual:   %v",
+					test.expectedResult,
+					result,
+				)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolynomialMatchesNaiveEvaluation(t *testing.T) {
+	for _, degree := range []int{0, 1, 5, 50, 200} {
+		coefficients, err := generatePolynomial(degree)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, memberID := range []group.MemberIndex{1, 2, 17, 255} {
+			x := big.NewInt(int64(memberID))
+
+			expected := evaluatePolynomialNaive(coefficients, x, bn256.Order)
+			actual := evaluatePolynomial(coefficients, x, bn256.Order)
+			if expected.Cmp(actual) != 0 {
+				t.Errorf(
+					"unexpected evaluation of degree [%v] polynomial "+
+						"at [%v]\nexpected: %v\nactual:   %v",
+					degree,
+					x,
+					expected,
+					actual,
+				)
+			}
+		}
+	}
+}
+
+func BenchmarkEvaluatePolynomial(b *testing.B) {
+	x := big.NewInt(255)
+
+	for _, degree := range []int{10, 50, 200} {
+		coefficients, err := generatePolynomial(degree)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(fmt.Sprintf("degree=%v,horner", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				evaluatePolynomial(coefficients, x, bn256.Order)
+			}
+		})
+
+		b.Run(fmt.Sprintf("degree=%v,naive", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				evaluatePolynomialNaive(coefficients, x, bn256.Order)
+			}
+		})
+	}
+}
+
+// evaluatePolynomialNaive evaluates the polynomial as `Σ a_k * x^k mod q`,
+// computing every power of `x` separately. It is used to cross-check
+// evaluatePolynomial.
+func evaluatePolynomialNaive(coefficients []*big.Int, x, q *big.Int) *big.Int {
+	result := big.NewInt(0)
+	for k, a := range coefficients {
+		result = new(big.Int).Mod(
+			new(big.Int).Add(
+				result,
+				new(big.Int).Mul(a, new(big.Int).Exp(x, big.NewInt(int64(k)), nil)),
+			),
+			q,
+		)
+	}
+	return result
+}
+
 func initializeQualifiedMembersGroup(dishonestThreshold, groupSize int) (
 	[]*QualifiedMember,
 	error,
@@ -0,0 +1,307 @@
+package gjkr
+
+import (
+	crand "crypto/rand"
+	"fmt"
+	"math/big"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/internal/wipe"
+)
+
+// Resharing lets qualified members of an existing group hand their shares of
+// the group private key over to a new, possibly larger, set of members without
+// changing the group private key `x` and hence the group public key `Y`.
+//
+// Each resharing member `i` acts as a dealer. It generates a random polynomial
+// `g_i` of degree equal to the new dishonest threshold such that `g_i(0) = x_i`,
+// where `x_i` is the dealer's share of the group private key. The dealer sends
+// `g_i(j)` to each new member `j` and broadcasts commitments `G * b_ik` to
+// coefficients `b_ik` of the polynomial.
+//
+// New member `j` verifies each received share against dealer's commitments and
+// verifies the first commitment is equal to dealer's group public key share
+// `G * x_i` established by the key generation. Then, it combines shares from
+// the set of dealers agreed by all new members with Lagrange coefficients
+// `λ_i` calculated for that set:
+// `x'_j = Σ λ_i * g_i(j) mod q`. Since `Σ λ_i * x_i = x`, new shares `x'_j`
+// are shares of the same group private key.
+//
+// Shares of the old group remain valid shares of `x` and can be still used
+// for signing unless they are explicitly retired. Shares of the old and the
+// new group can not be mixed when signing though, since they lie on different
+// polynomials.
+
+// ResharingDealer is a qualified member of an existing group resharing its
+// share of the group private key to a new set of members.
+type ResharingDealer struct {
+	// ID of the dealer in the existing group.
+	ID group.MemberIndex
+
+	coefficients []*big.Int // b_ik, b_i0 = x_i
+}
+
+// NewResharingDealer creates a dealer resharing the given share of the group
+// private key to a new group tolerating the given number of dishonest members.
+func NewResharingDealer(
+	memberID group.MemberIndex,
+	groupPrivateKeyShare *big.Int,
+	newDishonestThreshold int,
+) (*ResharingDealer, error) {
+	if newDishonestThreshold < 0 {
+		return nil, fmt.Errorf(
+			"%w: new dishonest threshold must not be negative; has [%v]",
+			ErrInvalidConfig,
+			newDishonestThreshold,
+		)
+	}
+
+	coefficients := make([]*big.Int, newDishonestThreshold+1)
+	coefficients[0] = new(big.Int).Mod(groupPrivateKeyShare, bn256.Order)
+	for k := 1; k < len(coefficients); k++ {
+		coefficient, err := crand.Int(crand.Reader, bn256.Order)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not generate resharing polynomial: [%v]",
+				err,
+			)
+		}
+		coefficients[k] = coefficient
+	}
+
+	return &ResharingDealer{
+		ID:           memberID,
+		coefficients: coefficients,
+	}, nil
+}
+
+// Commitments returns dealer's commitments `G * b_ik` to coefficients of the
+// resharing polynomial. They are expected to be broadcast to all new members.
+func (rd *ResharingDealer) Commitments() []*bn256.G2 {
+	commitments := make([]*bn256.G2, len(rd.coefficients))
+	for k, coefficient := range rd.coefficients {
+		commitments[k] = new(bn256.G2).ScalarBaseMult(coefficient)
+	}
+	return commitments
+}
+
+// ShareFor evaluates the resharing polynomial `g_i(j)` for the new member `j`.
+// The share is secret and should be delivered only to that member.
+func (rd *ResharingDealer) ShareFor(newMemberID group.MemberIndex) *big.Int {
+	return evaluatePolynomial(
+		rd.coefficients,
+		group.MemberIndexToBigInt(newMemberID),
+		bn256.Order,
+	)
+}
+
+// Wipe overwrites coefficients of the resharing polynomial with zeros.
+func (rd *ResharingDealer) Wipe() {
+	for _, coefficient := range rd.coefficients {
+		wipe.Int(coefficient)
+	}
+}
+
+// CombineReshares verifies shares `g_i(j)` received by the new member `j` from
+// dealers `i` and combines them into the new member's share of the group
+// private key `x'_j = Σ λ_i * g_i(j) mod q`.
+//
+// Dealers are the agreed set of dealers whose shares all members of the new
+// group combine. All new members have to use the same set; otherwise, their
+// shares lie on different polynomials. A share from each dealer in the set is
+// required and the set has to contain at least dishonest threshold + 1 dealers
+// of the existing group. Shares of dealers outside of the set are ignored.
+//
+// Each share is verified against the commitments broadcast by its dealer and
+// the first commitment is verified against dealer's group public key share
+// `G * x_i` of the existing group. Each dealer has to commit to a polynomial
+// of degree equal to the new dishonest threshold so that no dealer can raise
+// the threshold of the new group.
+func CombineReshares(
+	newMemberID group.MemberIndex,
+	dishonestThreshold int,
+	newDishonestThreshold int,
+	dealerIDs []group.MemberIndex,
+	shares map[group.MemberIndex]*big.Int,
+	commitments map[group.MemberIndex][]*bn256.G2,
+	groupPublicKeyShares map[group.MemberIndex]*bn256.G2,
+) (*big.Int, error) {
+	if err := validateEvaluationPoint(newMemberID); err != nil {
+		return nil, err
+	}
+
+	dealerIDs, err := validateDealers(dealerIDs, dishonestThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dealerID := range dealerIDs {
+		share, ok := shares[dealerID]
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: no reshare of dealer [%v]",
+				ErrInsufficientShares,
+				dealerID,
+			)
+		}
+
+		dealerCommitments, err := dealerCommitments(
+			dealerID,
+			commitments,
+			newDishonestThreshold,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		groupPublicKeyShare, ok := groupPublicKeyShares[dealerID]
+		if !ok || !constantTimeG2Equal(dealerCommitments[0], groupPublicKeyShare) {
+			return nil, fmt.Errorf(
+				"%w: commitments of dealer [%v] do not match "+
+					"its group public key share",
+				ErrVerificationFailed,
+				dealerID,
+			)
+		}
+
+		// G * g_i(j) == Σ (C_ik * j^k)
+		expectedShare := evaluateCommitments(newMemberID, dealerCommitments)
+		if !constantTimeG2Equal(
+			new(bn256.G2).ScalarBaseMult(share),
+			expectedShare,
+		) {
+			return nil, fmt.Errorf(
+				"%w: share of dealer [%v] does not match its commitments",
+				ErrVerificationFailed,
+				dealerID,
+			)
+		}
+	}
+
+	return interpolateShare(0, shares, dealerIDs), nil
+}
+
+// ResharedGroupPublicKeyShare calculates the group public key share `G * x'_j`
+// of the new member `j` from commitments broadcast by dealers. It lets members
+// of the new group verify signature shares of other members.
+//
+// Dealers have to be the same agreed set of dealers used to combine shares of
+// the new group with CombineReshares. Commitments of each dealer in the set
+// are required and have to be of the length equal to the new dishonest
+// threshold + 1.
+func ResharedGroupPublicKeyShare(
+	newMemberID group.MemberIndex,
+	newDishonestThreshold int,
+	dealerIDs []group.MemberIndex,
+	commitments map[group.MemberIndex][]*bn256.G2,
+) (*bn256.G2, error) {
+	dealerIDs, err := validateDealers(dealerIDs, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum *bn256.G2
+	for _, dealerID := range dealerIDs {
+		dealerCommitments, err := dealerCommitments(
+			dealerID,
+			commitments,
+			newDishonestThreshold,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// λ_i * Σ (C_ik * j^k)
+		share := new(bn256.G2).ScalarMult(
+			evaluateCommitments(newMemberID, dealerCommitments),
+			calculateLagrangeCoefficientAt(0, dealerID, dealerIDs),
+		)
+		if sum == nil {
+			sum = share
+		} else {
+			sum = new(bn256.G2).Add(sum, share)
+		}
+	}
+	return sum, nil
+}
+
+// validateDealers returns sorted copy of the given set of dealers. An error
+// is returned if the set contains duplicates or less than dishonest
+// threshold + 1 dealers.
+func validateDealers(
+	dealerIDs []group.MemberIndex,
+	dishonestThreshold int,
+) ([]group.MemberIndex, error) {
+	if len(dealerIDs) < dishonestThreshold+1 {
+		return nil, fmt.Errorf(
+			"%w: [%v] dealers; [%v] are required",
+			ErrInsufficientShares,
+			len(dealerIDs),
+			dishonestThreshold+1,
+		)
+	}
+
+	sorted := append([]group.MemberIndex{}, dealerIDs...)
+	group.SortMemberIndexes(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] == sorted[i-1] {
+			return nil, fmt.Errorf(
+				"%w: dealer [%v] is duplicated",
+				ErrInvalidConfig,
+				sorted[i],
+			)
+		}
+	}
+
+	return sorted, nil
+}
+
+// dealerCommitments returns commitments of the given dealer. An error is
+// returned if there are no commitments of the dealer or if the dealer
+// committed to a polynomial of a degree other than the new dishonest
+// threshold.
+func dealerCommitments(
+	dealerID group.MemberIndex,
+	commitments map[group.MemberIndex][]*bn256.G2,
+	newDishonestThreshold int,
+) ([]*bn256.G2, error) {
+	dealerCommitments, ok := commitments[dealerID]
+	if !ok {
+		return nil, fmt.Errorf(
+			"%w: no commitments of dealer [%v]",
+			ErrVerificationFailed,
+			dealerID,
+		)
+	}
+
+	if len(dealerCommitments) != newDishonestThreshold+1 {
+		return nil, fmt.Errorf(
+			"%w: dealer [%v] sent [%v] commitments; [%v] are required",
+			ErrVerificationFailed,
+			dealerID,
+			len(dealerCommitments),
+			newDishonestThreshold+1,
+		)
+	}
+
+	return dealerCommitments, nil
+}
+
+// evaluateCommitments calculates `Σ (C_k * j^k)` for `k` in `[0..T]`, which is
+// the public counterpart of the share of the member `j`.
+func evaluateCommitments(
+	memberID group.MemberIndex,
+	commitments []*bn256.G2,
+) *bn256.G2 {
+	var sum *bn256.G2
+	for k, c := range commitments {
+		ck := new(bn256.G2).ScalarMult(c, pow(memberID, k))
+		if sum == nil {
+			sum = ck
+		} else {
+			sum = new(bn256.G2).Add(sum, ck)
+		}
+	}
+	return sum
+}
@@ -0,0 +1,289 @@
+package gjkr
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestResharing(t *testing.T) {
+	groupSize := 5
+	dishonestThreshold := 2
+	newGroupSize := 7
+	newDishonestThreshold := 3
+
+	// Shares of the group private key `x` dealt to the existing group.
+	groupPolynomial, err := generatePolynomial(dishonestThreshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupPrivateKey := groupPolynomial[0]
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(groupPrivateKey)
+
+	groupPrivateKeyShares := make(map[group.MemberIndex]*big.Int)
+	groupPublicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+	for i := 1; i <= groupSize; i++ {
+		memberID := group.MemberIndex(i)
+		groupPrivateKeyShares[memberID] = evaluatePolynomial(
+			groupPolynomial,
+			big.NewInt(int64(memberID)),
+			bn256.Order,
+		)
+		groupPublicKeyShares[memberID] = new(bn256.G2).ScalarBaseMult(
+			groupPrivateKeyShares[memberID],
+		)
+	}
+
+	commitments := make(map[group.MemberIndex][]*bn256.G2)
+	newShares := make(map[group.MemberIndex]map[group.MemberIndex]*big.Int)
+	for j := 1; j <= newGroupSize; j++ {
+		newShares[group.MemberIndex(j)] = make(map[group.MemberIndex]*big.Int)
+	}
+
+	for dealerID, share := range groupPrivateKeyShares {
+		dealer, err := NewResharingDealer(dealerID, share, newDishonestThreshold)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		commitments[dealerID] = dealer.Commitments()
+		for newMemberID := range newShares {
+			newShares[newMemberID][dealerID] = dealer.ShareFor(newMemberID)
+		}
+	}
+
+	// New member 7 has not received a share from dealer 5. It does not
+	// matter, since dealer 5 is not in the set of dealers agreed by the new
+	// group.
+	delete(newShares[7], 5)
+	dealerIDs := []group.MemberIndex{4, 1, 2}
+
+	newGroupPrivateKeyShares := make(map[group.MemberIndex]*big.Int)
+	for newMemberID, shares := range newShares {
+		newShare, err := CombineReshares(
+			newMemberID,
+			dishonestThreshold,
+			newDishonestThreshold,
+			dealerIDs,
+			shares,
+			commitments,
+			groupPublicKeyShares,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		newGroupPrivateKeyShares[newMemberID] = newShare
+
+		expectedPublicKeyShare := new(bn256.G2).ScalarBaseMult(newShare)
+		publicKeyShare, err := ResharedGroupPublicKeyShare(
+			newMemberID,
+			newDishonestThreshold,
+			dealerIDs,
+			commitments,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !constantTimeG2Equal(expectedPublicKeyShare, publicKeyShare) {
+			t.Errorf(
+				"unexpected group public key share of new member [%v]"+
+					"\nexpected: %v\nactual:   %v\n",
+				newMemberID,
+				expectedPublicKeyShare,
+				publicKeyShare,
+			)
+		}
+	}
+
+	var tests = map[string]struct {
+		shares    map[group.MemberIndex]*big.Int
+		memberIDs []group.MemberIndex
+	}{
+		"old group shares": {
+			shares:    groupPrivateKeyShares,
+			memberIDs: []group.MemberIndex{1, 3, 5},
+		},
+		"new group shares of first members": {
+			shares:    newGroupPrivateKeyShares,
+			memberIDs: []group.MemberIndex{1, 2, 3, 4},
+		},
+		"new group shares of new members": {
+			shares:    newGroupPrivateKeyShares,
+			memberIDs: []group.MemberIndex{2, 5, 6, 7},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			secret := interpolateShare(0, test.shares, test.memberIDs)
+			if secret.Cmp(groupPrivateKey) != 0 {
+				t.Errorf(
+					"unexpected reconstructed group private key"+
+						"\nexpected: %v\nactual:   %v\n",
+					groupPrivateKey,
+					secret,
+				)
+			}
+
+			publicKey := new(bn256.G2).ScalarBaseMult(secret)
+			if !constantTimeG2Equal(groupPublicKey, publicKey) {
+				t.Errorf(
+					"unexpected group public key\nexpected: %v\nactual:   %v\n",
+					groupPublicKey,
+					publicKey,
+				)
+			}
+		})
+	}
+}
+
+func TestCombineResharesVerification(t *testing.T) {
+	dishonestThreshold := 1
+	newDishonestThreshold := 2
+	newMemberID := group.MemberIndex(4)
+
+	groupPrivateKeyShares := map[group.MemberIndex]*big.Int{
+		1: big.NewInt(11),
+		2: big.NewInt(12),
+		3: big.NewInt(13),
+	}
+
+	var tests = map[string]struct {
+		dealerIDs     []group.MemberIndex
+		modify        func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2)
+		expectedError error
+	}{
+		"valid reshares": {},
+		"valid reshares of the agreed dealers": {
+			dealerIDs: []group.MemberIndex{1, 3},
+			modify: func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2) {
+				delete(shares, 2)
+			},
+		},
+		"share not matching commitments": {
+			modify: func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2) {
+				shares[2] = new(big.Int).Add(shares[2], big.NewInt(1))
+			},
+			expectedError: ErrVerificationFailed,
+		},
+		"commitments not matching group public key share": {
+			modify: func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2) {
+				commitments[3][0] = new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+			},
+			expectedError: ErrVerificationFailed,
+		},
+		"missing commitments": {
+			modify: func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2) {
+				delete(commitments, 1)
+			},
+			expectedError: ErrVerificationFailed,
+		},
+		"commitments of a higher degree polynomial": {
+			modify: func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2) {
+				commitments[2] = append(
+					commitments[2],
+					new(bn256.G2).ScalarBaseMult(big.NewInt(1)),
+				)
+			},
+			expectedError: ErrVerificationFailed,
+		},
+		"missing reshare of an agreed dealer": {
+			modify: func(shares map[group.MemberIndex]*big.Int, commitments map[group.MemberIndex][]*bn256.G2) {
+				delete(shares, 2)
+			},
+			expectedError: ErrInsufficientShares,
+		},
+		"not enough dealers": {
+			dealerIDs:     []group.MemberIndex{3},
+			expectedError: ErrInsufficientShares,
+		},
+		"duplicated dealer": {
+			dealerIDs:     []group.MemberIndex{1, 3, 1},
+			expectedError: ErrInvalidConfig,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			shares := make(map[group.MemberIndex]*big.Int)
+			commitments := make(map[group.MemberIndex][]*bn256.G2)
+			groupPublicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+
+			for dealerID, share := range groupPrivateKeyShares {
+				dealer, err := NewResharingDealer(
+					dealerID,
+					share,
+					newDishonestThreshold,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				shares[dealerID] = dealer.ShareFor(newMemberID)
+				commitments[dealerID] = dealer.Commitments()
+				groupPublicKeyShares[dealerID] = new(bn256.G2).ScalarBaseMult(share)
+			}
+
+			if test.modify != nil {
+				test.modify(shares, commitments)
+			}
+
+			dealerIDs := test.dealerIDs
+			if dealerIDs == nil {
+				dealerIDs = []group.MemberIndex{1, 2, 3}
+			}
+
+			_, err := CombineReshares(
+				newMemberID,
+				dishonestThreshold,
+				newDishonestThreshold,
+				dealerIDs,
+				shares,
+				commitments,
+				groupPublicKeyShares,
+			)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestResharedGroupPublicKeyShareMissingCommitments(t *testing.T) {
+	newDishonestThreshold := 1
+	newMemberID := group.MemberIndex(2)
+
+	commitments := make(map[group.MemberIndex][]*bn256.G2)
+	for _, dealerID := range []group.MemberIndex{1, 2} {
+		dealer, err := NewResharingDealer(
+			dealerID,
+			big.NewInt(int64(10+dealerID)),
+			newDishonestThreshold,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitments[dealerID] = dealer.Commitments()
+	}
+
+	_, err := ResharedGroupPublicKeyShare(
+		newMemberID,
+		newDishonestThreshold,
+		[]group.MemberIndex{1, 2, 3},
+		commitments,
+	)
+	if !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			ErrVerificationFailed,
+			err,
+		)
+	}
+}
@@ -19,16 +19,43 @@ type Result struct {
 	// Share of the group private key. It is used for signing and should never
 	// be revealed publicly.
 	GroupPrivateKeyShare *big.Int
+	// Degree of polynomials used to generate the group key. At least
+	// PolynomialDegree + 1 signature shares are needed to produce a group
+	// signature.
+	PolynomialDegree int
+	// Transcript of the key generation containing all public messages
+	// broadcast in the group. It can be verified with VerifyTranscript.
+	Transcript *Transcript
+	// Records of members disqualified during the key generation explaining
+	// in which phase and why each member has been disqualified, in the order
+	// of disqualification.
+	Disqualifications []DisqualificationRecord
+	// Errors of individual public keys of misbehaved members reconstructed in
+	// phase 11 which do not match individual public keys those members
+	// broadcast in phase 7. Such members broadcast public key share points
+	// inconsistent with their shares.
+	ReconstructionErrors []error
 
 	groupPublicKeySharesMutex   sync.Mutex
 	groupPublicKeySharesChannel <-chan map[group.MemberIndex]*bn256.G2
 	groupPublicKeyShares        map[group.MemberIndex]*bn256.G2
 }
 
+// DisqualificationRecord describes why a member has been disqualified
+// during the key generation.
+type DisqualificationRecord struct {
+	// ID of the disqualified member.
+	MemberID group.MemberIndex
+	// Protocol phase in which the member has been disqualified.
+	Phase int
+	// Misbehaviour the member has been disqualified for.
+	Reason string
+}
+
 // GroupPublicKeyBytes returns marshalled group public key.
 func (r *Result) GroupPublicKeyBytes() ([]byte, error) {
 	if r.GroupPublicKey == nil {
-		return nil, fmt.Errorf("group public key is nil")
+		return nil, fmt.Errorf("%w: group public key is nil", ErrInsufficientShares)
 	}
 
 	return r.GroupPublicKey.Marshal(), nil
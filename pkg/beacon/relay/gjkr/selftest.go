@@ -0,0 +1,350 @@
+package gjkr
+
+import (
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	commonLocal "github.com/keep-network/keep-common/pkg/chain/local"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+const (
+	selfTestRandomSeed         = 7
+	selfTestGroupSize          = 3
+	selfTestDishonestThreshold = 1
+
+	// Blocks of the self test are simulated locally, so they can be much
+	// shorter than blocks of a real chain.
+	selfTestBlockTime        = 50 * time.Millisecond
+	selfTestStartBlockHeight = 1
+	selfTestTimeout          = time.Minute
+)
+
+var selfTestProtocolSeed = big.NewInt(1907)
+
+// SelfTest exercises cryptographic primitives the protocol depends on with
+// small, deterministic instances: Pedersen commitments verification,
+// ephemeral ECDH key agreement and a full execution of the protocol for a tiny
+// group on a local broadcast channel. It is meant to be called before the client joins any
+// group to confirm the build is functional. The first failure is returned
+// with a context of the check which failed.
+func SelfTest() error {
+	return newSelfTest().run()
+}
+
+// selfTest holds parameters of the self test. Commitments are calculated with
+// commitmentParameters and verified with verificationParameters; both are the
+// same unless the self test is deliberately broken.
+type selfTest struct {
+	commitmentParameters   *protocolParameters
+	verificationParameters *protocolParameters
+	randomSource           func() io.Reader
+}
+
+func newSelfTest() *selfTest {
+	parameters := newProtocolParameters(selfTestProtocolSeed)
+
+	return &selfTest{
+		commitmentParameters:   parameters,
+		verificationParameters: parameters,
+		randomSource: func() io.Reader {
+			return mrand.New(mrand.NewSource(selfTestRandomSeed))
+		},
+	}
+}
+
+func (st *selfTest) run() error {
+	if err := st.checkCommitments(); err != nil {
+		return fmt.Errorf("commitments check failed: [%v]", err)
+	}
+
+	if err := st.checkEphemeralKeyAgreement(); err != nil {
+		return fmt.Errorf("ephemeral key agreement check failed: [%v]", err)
+	}
+
+	if err := st.checkKeyGeneration(); err != nil {
+		return fmt.Errorf("key generation check failed: [%v]", err)
+	}
+
+	return nil
+}
+
+// checkCommitments commits to coefficients of fixed polynomials and checks
+// that shares evaluated for each member verify against those commitments
+// while a tampered share does not.
+func (st *selfTest) checkCommitments() error {
+	coefficientsA := []*big.Int{big.NewInt(3), big.NewInt(5), big.NewInt(7)}
+	coefficientsB := []*big.Int{big.NewInt(11), big.NewInt(13), big.NewInt(17)}
+
+	committer := &CommittingMember{}
+	committer.SymmetricKeyGeneratingMember = &SymmetricKeyGeneratingMember{
+		EphemeralKeyPairGeneratingMember: &EphemeralKeyPairGeneratingMember{
+			LocalMember: &LocalMember{
+				memberCore: &memberCore{
+					protocolParameters: st.commitmentParameters,
+				},
+			},
+		},
+	}
+	verifier := &CommittingMember{}
+	verifier.SymmetricKeyGeneratingMember = &SymmetricKeyGeneratingMember{
+		EphemeralKeyPairGeneratingMember: &EphemeralKeyPairGeneratingMember{
+			LocalMember: &LocalMember{
+				memberCore: &memberCore{
+					protocolParameters: st.verificationParameters,
+				},
+			},
+		},
+	}
+
+	commitments := make([]*bn256.G1, len(coefficientsA))
+	for k := range commitments {
+		commitments[k] = committer.calculateCommitment(
+			coefficientsA[k],
+			coefficientsB[k],
+		)
+	}
+
+	for i := 1; i <= selfTestGroupSize; i++ {
+		memberID := group.MemberIndex(i)
+		shareS := committer.evaluateMemberShare(memberID, coefficientsA)
+		shareT := committer.evaluateMemberShare(memberID, coefficientsB)
+
+		if !verifier.areSharesValidAgainstCommitments(
+			shareS,
+			shareT,
+			commitments,
+			memberID,
+		) {
+			return fmt.Errorf(
+				"valid shares for member [%v] rejected",
+				memberID,
+			)
+		}
+
+		tamperedShareS := new(big.Int).Add(shareS, big.NewInt(1))
+		if verifier.areSharesValidAgainstCommitments(
+			tamperedShareS,
+			shareT,
+			commitments,
+			memberID,
+		) {
+			return fmt.Errorf(
+				"tampered shares for member [%v] accepted",
+				memberID,
+			)
+		}
+	}
+
+	return nil
+}
+
+// checkEphemeralKeyAgreement generates two ephemeral key pairs, performs ECDH
+// from both sides and checks a message encrypted with one symmetric key
+// decrypts with the other.
+func (st *selfTest) checkEphemeralKeyAgreement() error {
+	randomSource := st.randomSource()
+
+	keyPair1, err := ephemeral.GenerateKeyPair(randomSource)
+	if err != nil {
+		return err
+	}
+	keyPair2, err := ephemeral.GenerateKeyPair(randomSource)
+	if err != nil {
+		return err
+	}
+
+	symmetricKey1 := keyPair1.PrivateKey.Ecdh(keyPair2.PublicKey)
+	symmetricKey2 := keyPair2.PrivateKey.Ecdh(keyPair1.PublicKey)
+
+	plaintext := []byte("keep self test")
+
+	ciphertext, err := symmetricKey1.Encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+
+	decrypted, err := symmetricKey2.Decrypt(ciphertext)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(plaintext, decrypted) {
+		return fmt.Errorf("decrypted message does not match the original one")
+	}
+
+	return nil
+}
+
+// checkKeyGeneration executes the protocol for a tiny group of honest members
+// on a local broadcast channel and checks that no member has been
+// disqualified, that all members computed the same group public key and that
+// the group private key interpolated from the members' shares corresponds to
+// that public key. The first member commits to its shares with commitment
+// parameters while the other members commit and verify with verification
+// parameters.
+func (st *selfTest) checkKeyGeneration() error {
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		return err
+	}
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	signing := commonLocal.NewSigner(privateKey)
+	address := signing.PublicKeyBytesToAddress(key.Marshal(networkPublicKey))
+
+	channel, err := netLocal.ConnectWithKey(networkPublicKey).BroadcastChannelFor(
+		fmt.Sprintf("gjkr-self-test-%x", address),
+	)
+	if err != nil {
+		return err
+	}
+	RegisterUnmarshallers(channel)
+
+	stakers := make([]relaychain.StakerAddress, selfTestGroupSize)
+	for i := range stakers {
+		stakers[i] = address
+	}
+	membershipValidator := group.NewStakersMembershipValidator(stakers, signing)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), selfTestTimeout)
+	defer cancelCtx()
+
+	blockCounter := chainLocal.NewSimulatedBlockCounter()
+	go func() {
+		ticker := time.NewTicker(selfTestBlockTime)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				blockCounter.AdvanceBlocks(1)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	members := make([]*LocalMember, selfTestGroupSize)
+	for i := range members {
+		member, err := NewMember(
+			group.MemberIndex(i+1),
+			selfTestGroupSize,
+			selfTestDishonestThreshold,
+			membershipValidator,
+			selfTestProtocolSeed,
+			crand.Reader,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		if err := member.SetSigning(signing); err != nil {
+			return err
+		}
+
+		if i == 0 {
+			member.protocolParameters = st.commitmentParameters
+		} else {
+			member.protocolParameters = st.verificationParameters
+		}
+
+		members[i] = member
+	}
+
+	results := make([]*Result, len(members))
+	errs := make([]error, len(members))
+
+	var wg sync.WaitGroup
+	wg.Add(len(members))
+	for i, member := range members {
+		go func(i int, member *LocalMember) {
+			defer wg.Done()
+			results[i], _, errs[i] = execute(
+				ctx,
+				member,
+				blockCounter,
+				channel,
+				selfTestStartBlockHeight,
+				nil,
+			)
+		}(i, member)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("member [%v] failed: [%v]", members[i].ID, err)
+		}
+	}
+
+	groupPublicKey := results[0].GroupPublicKey
+	for i, result := range results {
+		if len(result.Group.OperatingMemberIDs()) != selfTestGroupSize {
+			return fmt.Errorf(
+				"member [%v] disqualified honest members [%v] and "+
+					"marked honest members [%v] as inactive",
+				members[i].ID,
+				result.Group.DisqualifiedMemberIDs(),
+				result.Group.InactiveMemberIDs(),
+			)
+		}
+
+		if result.GroupPublicKey == nil ||
+			result.GroupPublicKey.String() != groupPublicKey.String() {
+			return fmt.Errorf(
+				"member [%v] computed a different group public key",
+				members[i].ID,
+			)
+		}
+	}
+
+	// Interpolate the group private key from shares of `T + 1` members.
+	signingMembersIDs := make([]group.MemberIndex, selfTestDishonestThreshold+1)
+	for i := range signingMembersIDs {
+		signingMembersIDs[i] = members[i].ID
+	}
+
+	groupPrivateKey := big.NewInt(0)
+	for i, memberID := range signingMembersIDs {
+		lagrangeCoefficient := calculateLagrangeCoefficientAt(
+			0,
+			memberID,
+			signingMembersIDs,
+		)
+		groupPrivateKey = new(big.Int).Mod(
+			new(big.Int).Add(
+				groupPrivateKey,
+				new(big.Int).Mul(
+					results[i].GroupPrivateKeyShare,
+					lagrangeCoefficient,
+				),
+			),
+			bn256.Order,
+		)
+	}
+
+	if new(bn256.G2).ScalarBaseMult(groupPrivateKey).String() !=
+		groupPublicKey.String() {
+		return fmt.Errorf(
+			"group private key interpolated from shares does not match " +
+				"the group public key",
+		)
+	}
+
+	return nil
+}
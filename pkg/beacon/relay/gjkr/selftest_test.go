@@ -0,0 +1,42 @@
+package gjkr
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatalf("unexpected error [%v]", err)
+	}
+}
+
+func TestSelfTestDetectsBrokenCommitmentsVerification(t *testing.T) {
+	st := newSelfTest()
+	st.verificationParameters = newProtocolParameters(big.NewInt(1410))
+
+	err := st.run()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	expectedPrefix := "commitments check failed"
+	if !strings.HasPrefix(err.Error(), expectedPrefix) {
+		t.Fatalf(
+			"unexpected error\nexpected prefix: %v\nactual:          %v",
+			expectedPrefix,
+			err,
+		)
+	}
+}
+
+func TestSelfTestDetectsBrokenKeyGeneration(t *testing.T) {
+	st := newSelfTest()
+	st.verificationParameters = newProtocolParameters(big.NewInt(1410))
+
+	err := st.checkKeyGeneration()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
 
 type keyGenerationState = state.State
@@ -82,6 +83,8 @@ func (ekpgs *ephemeralKeyPairGenerationState) Receive(msg net.Message) error {
 }
 
 func (ekpgs *ephemeralKeyPairGenerationState) Next() keyGenerationState {
+	ekpgs.member.reportPhaseCompleted(1, len(ekpgs.phaseMessages), 1)
+
 	return &symmetricKeyGenerationState{
 		channel:               ekpgs.channel,
 		member:                ekpgs.member.InitializeSymmetricKeyGeneration(),
@@ -115,7 +118,13 @@ func (skgs *symmetricKeyGenerationState) ActiveBlocks() uint64 {
 
 func (skgs *symmetricKeyGenerationState) Initiate(ctx context.Context) error {
 	skgs.member.MarkInactiveMembers(skgs.previousPhaseMessages)
-	return skgs.member.GenerateSymmetricKeys(skgs.previousPhaseMessages)
+	if err := skgs.member.GenerateSymmetricKeys(
+		skgs.previousPhaseMessages,
+	); err != nil {
+		return err
+	}
+
+	return skgs.member.checkOperatingMembers()
 }
 
 func (skgs *symmetricKeyGenerationState) Receive(msg net.Message) error {
@@ -123,6 +132,8 @@ func (skgs *symmetricKeyGenerationState) Receive(msg net.Message) error {
 }
 
 func (skgs *symmetricKeyGenerationState) Next() keyGenerationState {
+	skgs.member.reportPhaseCompleted(2, 0, 0)
+
 	return &commitmentState{
 		channel: skgs.channel,
 		member:  skgs.member.InitializeCommitting(),
@@ -196,6 +207,12 @@ func (cs *commitmentState) Receive(msg net.Message) error {
 }
 
 func (cs *commitmentState) Next() keyGenerationState {
+	cs.member.reportPhaseCompleted(
+		3,
+		len(cs.phaseSharesMessages)+len(cs.phaseCommitmentsMessages),
+		2,
+	)
+
 	return &commitmentsVerificationState{
 		channel: cs.channel,
 		member:  cs.member.InitializeCommitmentsVerification(),
@@ -249,7 +266,7 @@ func (cvs *commitmentsVerificationState) Initiate(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return cvs.member.checkOperatingMembers()
 }
 
 func (cvs *commitmentsVerificationState) Receive(msg net.Message) error {
@@ -269,6 +286,8 @@ func (cvs *commitmentsVerificationState) Receive(msg net.Message) error {
 }
 
 func (cvs *commitmentsVerificationState) Next() keyGenerationState {
+	cvs.member.reportPhaseCompleted(4, len(cvs.phaseAccusationsMessages), 1)
+
 	return &sharesJustificationState{
 		channel: cvs.channel,
 		member:  cvs.member.InitializeSharesJustification(),
@@ -283,6 +302,8 @@ func (cvs *commitmentsVerificationState) MemberIndex() group.MemberIndex {
 
 // sharesJustificationState is the state during which members resolve
 // accusations published by other group members in the previous state.
+// Unsigned, forged and replayed accusations are rejected if the member has
+// a signer.
 // No messages are valid in this state.
 //
 // State covers phase 5 of the protocol.
@@ -302,16 +323,30 @@ func (sjs *sharesJustificationState) ActiveBlocks() uint64 {
 }
 
 func (sjs *sharesJustificationState) Initiate(ctx context.Context) error {
-	sjs.member.MarkInactiveMembers(sjs.previousPhaseAccusationsMessages)
+	accusationsMessages := sjs.member.authenticSecretSharesAccusationsMessages(
+		sjs.previousPhaseAccusationsMessages,
+	)
+
+	accusations := make(
+		map[group.MemberIndex]map[group.MemberIndex]*ephemeral.PrivateKey,
+	)
+	for _, message := range accusationsMessages {
+		accusations[message.senderID] = message.accusedMembersKeys
+	}
+	if err := sjs.member.checkAccusations(4, accusations); err != nil {
+		return err
+	}
+
+	sjs.member.MarkInactiveMembers(accusationsMessages)
 
 	err := sjs.member.ResolveSecretSharesAccusationsMessages(
-		sjs.previousPhaseAccusationsMessages,
+		accusationsMessages,
 	)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return sjs.member.checkOperatingMembers()
 }
 
 func (sjs *sharesJustificationState) Receive(msg net.Message) error {
@@ -319,6 +354,8 @@ func (sjs *sharesJustificationState) Receive(msg net.Message) error {
 }
 
 func (sjs *sharesJustificationState) Next() keyGenerationState {
+	sjs.member.reportPhaseCompleted(5, 0, 0)
+
 	return &qualificationState{
 		channel: sjs.channel,
 		member:  sjs.member.InitializeQualified(),
@@ -357,6 +394,9 @@ func (qs *qualificationState) Receive(msg net.Message) error {
 }
 
 func (qs *qualificationState) Next() keyGenerationState {
+	qs.member.reportPhaseCompleted(6, 0, 0)
+	qs.member.wipeSelfSecretShares()
+
 	return &pointsShareState{
 		channel: qs.channel,
 		member:  qs.member.InitializeSharing(),
@@ -410,6 +450,9 @@ func (pss *pointsShareState) Receive(msg net.Message) error {
 }
 
 func (pss *pointsShareState) Next() keyGenerationState {
+	pss.member.reportPhaseCompleted(7, len(pss.phaseMessages), 1)
+	pss.member.wipeSecretCoefficients()
+
 	return &pointsValidationState{
 		channel: pss.channel,
 		member:  pss.member,
@@ -474,6 +517,8 @@ func (pvs *pointsValidationState) Receive(msg net.Message) error {
 }
 
 func (pvs *pointsValidationState) Next() keyGenerationState {
+	pvs.member.reportPhaseCompleted(8, len(pvs.phaseMessages), 1)
+
 	return &pointsJustificationState{
 		channel: pvs.channel,
 		member:  pvs.member.InitializePointsJustification(),
@@ -488,6 +533,8 @@ func (pvs *pointsValidationState) MemberIndex() group.MemberIndex {
 
 // pointsJustificationState is the state during which group members resolve
 // accusations published by other group members in the previous state.
+// Unsigned, forged and replayed accusations are rejected if the member has
+// a signer.
 // No messages are valid in this state.
 //
 // State covers phase 9 of the protocol.
@@ -507,16 +554,30 @@ func (pjs *pointsJustificationState) ActiveBlocks() uint64 {
 }
 
 func (pjs *pointsJustificationState) Initiate(ctx context.Context) error {
-	pjs.member.MarkInactiveMembers(pjs.previousPhaseMessages)
+	accusationsMessages := pjs.member.authenticPointsAccusationsMessages(
+		pjs.previousPhaseMessages,
+	)
+
+	accusations := make(
+		map[group.MemberIndex]map[group.MemberIndex]*ephemeral.PrivateKey,
+	)
+	for _, message := range accusationsMessages {
+		accusations[message.senderID] = message.accusedMembersKeys
+	}
+	if err := pjs.member.checkAccusations(8, accusations); err != nil {
+		return err
+	}
+
+	pjs.member.MarkInactiveMembers(accusationsMessages)
 
 	err := pjs.member.ResolvePublicKeySharePointsAccusationsMessages(
-		pjs.previousPhaseMessages,
+		accusationsMessages,
 	)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return pjs.member.checkOperatingMembers()
 }
 
 func (pjs *pointsJustificationState) Receive(msg net.Message) error {
@@ -524,6 +585,8 @@ func (pjs *pointsJustificationState) Receive(msg net.Message) error {
 }
 
 func (pjs *pointsJustificationState) Next() keyGenerationState {
+	pjs.member.reportPhaseCompleted(9, 0, 0)
+
 	return &keyRevealState{
 		channel: pjs.channel,
 		member:  pjs.member.InitializeRevealing(),
@@ -581,6 +644,11 @@ func (rs *keyRevealState) Receive(msg net.Message) error {
 }
 
 func (rs *keyRevealState) Next() keyGenerationState {
+	rs.member.reportPhaseCompleted(10, len(rs.phaseMessages), 1)
+	// Ephemeral private keys are last used in this phase to reveal keys
+	// generated for misbehaved members.
+	rs.member.EphemeralKeyPairGeneratingMember.Wipe()
+
 	return &reconstructionState{
 		channel:               rs.channel,
 		member:                rs.member.InitializeReconstruction(),
@@ -620,7 +688,7 @@ func (rs *reconstructionState) Initiate(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return rs.member.checkOperatingMembers()
 }
 
 func (rs *reconstructionState) Receive(msg net.Message) error {
@@ -628,6 +696,10 @@ func (rs *reconstructionState) Receive(msg net.Message) error {
 }
 
 func (rs *reconstructionState) Next() keyGenerationState {
+	rs.member.reportPhaseCompleted(11, 0, 0)
+	rs.member.wipeReceivedShares()
+	rs.member.wipeReconstructedIndividualPrivateKeys()
+
 	return &combinationState{
 		channel: rs.channel,
 		member:  rs.member.InitializeCombining(),
@@ -667,6 +739,8 @@ func (cs *combinationState) Receive(msg net.Message) error {
 }
 
 func (cs *combinationState) Next() keyGenerationState {
+	cs.member.reportPhaseCompleted(12, 0, 0)
+
 	return &finalizationState{
 		channel: cs.channel,
 		member:  cs.member.InitializeFinalization(),
@@ -711,6 +785,11 @@ func (fs *finalizationState) MemberIndex() group.MemberIndex {
 	return fs.member.ID
 }
 
+// result returns the result of the protocol and wipes member's secrets as
+// they are no longer needed once the result is produced.
 func (fs *finalizationState) result() *Result {
-	return fs.member.Result()
+	result := fs.member.Result()
+	fs.member.Wipe()
+
+	return result
 }
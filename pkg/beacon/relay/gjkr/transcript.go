@@ -0,0 +1,694 @@
+package gjkr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+)
+
+// Transcript is a record of a completed key generation, as seen by a single
+// member. It contains all public protocol messages broadcast in the group,
+// including messages of the member itself, in the order in which they were
+// received, along with the final result of the protocol.
+//
+// All fields are plain values so the transcript can be serialized with any
+// encoder and handed over to a third party for audit or dispute resolution.
+// See VerifyTranscript.
+type Transcript struct {
+	// Protocol messages broadcast during the key generation.
+	Messages []*TranscriptMessage
+	// Seed of the key generation, used to evaluate the commitments parameter.
+	Seed *big.Int
+	// Number of members initially selected to the group.
+	GroupSize int
+	// Maximum number of misbehaving members the group tolerated.
+	DishonestThreshold int
+	// Degree of polynomials generated by members; degree + 1 shares are
+	// required to reconstruct a secret.
+	PolynomialDegree int
+	// IDs of members in the QUAL set, that is members which provided valid
+	// shares in phase 3 and passed the secret shares accusations phase.
+	QualifiedMemberIDs []group.MemberIndex
+	// IDs of members disqualified during the key generation.
+	DisqualifiedMemberIDs []group.MemberIndex
+	// IDs of members marked as inactive during the key generation.
+	InactiveMemberIDs []group.MemberIndex
+	// Marshalled group public key produced by the protocol.
+	GroupPublicKey []byte
+}
+
+// TranscriptMessage is a single protocol message included in the transcript.
+type TranscriptMessage struct {
+	// ID of the member which sent the message, as declared in the message.
+	SenderID group.MemberIndex
+	// Type of the message, as returned by its Type function.
+	Type string
+	// The message marshalled with its Marshal function.
+	Payload []byte
+}
+
+// transcriptRecorder collects all protocol messages broadcast in the group
+// for the sake of building the transcript once the protocol completes.
+type transcriptRecorder struct {
+	mutex    sync.Mutex
+	messages []*TranscriptMessage
+}
+
+// recordTranscriptMessages registers a handler recording all protocol messages
+// delivered by the channel in the provided recorder, until the context is done.
+// Unlike recordReceivedMessages, messages sent by the member itself are
+// recorded as well since they are needed to replay the protocol.
+func recordTranscriptMessages(
+	ctx context.Context,
+	memberID group.MemberIndex,
+	channel net.BroadcastChannel,
+	recorder *transcriptRecorder,
+) {
+	channel.Recv(ctx, func(msg net.Message) {
+		protocolMessage, ok := msg.Payload().(group.ProtocolMessage)
+		if !ok {
+			return
+		}
+
+		taggedMessage, ok := protocolMessage.(net.TaggedMarshaler)
+		if !ok {
+			return
+		}
+
+		payload, err := taggedMessage.Marshal()
+		if err != nil {
+			logger.Warningf(
+				"[member:%v] could not record [%v] message from [%v] "+
+					"in the transcript: [%v]",
+				memberID,
+				taggedMessage.Type(),
+				protocolMessage.SenderID(),
+				err,
+			)
+			return
+		}
+
+		recorder.mutex.Lock()
+		defer recorder.mutex.Unlock()
+
+		recorder.messages = append(recorder.messages, &TranscriptMessage{
+			SenderID: protocolMessage.SenderID(),
+			Type:     taggedMessage.Type(),
+			Payload:  payload,
+		})
+	})
+}
+
+// transcript builds the transcript of the key generation completed by
+// the given member, using messages recorded so far.
+func (tr *transcriptRecorder) transcript(
+	member *FinalizingMember,
+) *Transcript {
+	tr.mutex.Lock()
+	messages := make([]*TranscriptMessage, len(tr.messages))
+	copy(messages, tr.messages)
+	tr.mutex.Unlock()
+
+	qualifiedMemberIDs := []group.MemberIndex{member.ID}
+	for memberID := range member.receivedQualifiedSharesS {
+		qualifiedMemberIDs = append(qualifiedMemberIDs, memberID)
+	}
+	group.SortMemberIndexes(qualifiedMemberIDs)
+
+	var groupPublicKey []byte
+	if member.groupPublicKey != nil {
+		groupPublicKey = member.groupPublicKey.Marshal()
+	}
+
+	return &Transcript{
+		Messages:              messages,
+		Seed:                  new(big.Int).Set(member.protocolParameters.seed),
+		GroupSize:             member.group.GroupSize(),
+		DishonestThreshold:    member.group.DishonestThreshold(),
+		PolynomialDegree:      member.polynomialDegree(),
+		QualifiedMemberIDs:    qualifiedMemberIDs,
+		DisqualifiedMemberIDs: member.group.DisqualifiedMemberIDs(),
+		InactiveMemberIDs:     member.group.InactiveMemberIDs(),
+		GroupPublicKey:        groupPublicKey,
+	}
+}
+
+// transcriptMessages holds messages unmarshalled from the transcript, indexed
+// by the sender. Only the first message of the given type from the given
+// sender is taken into account, the same way as members do.
+type transcriptMessages struct {
+	ephemeralPublicKeys  map[group.MemberIndex]*EphemeralPublicKeyMessage
+	commitments          map[group.MemberIndex]*MemberCommitmentsMessage
+	peerShares           map[group.MemberIndex]*PeerSharesMessage
+	sharesAccusations    map[group.MemberIndex]*SecretSharesAccusationsMessage
+	publicKeySharePoints map[group.MemberIndex]*MemberPublicKeySharePointsMessage
+	pointsAccusations    map[group.MemberIndex]*PointsAccusationsMessage
+	misbehavedKeys       []*MisbehavedEphemeralKeysMessage
+}
+
+// transcriptReplay replays the key generation from messages of the
+// transcript, from the point of view of a third party which is not a member
+// of the group. The group tracks members disqualified or marked as inactive
+// so far.
+type transcriptReplay struct {
+	messages         *transcriptMessages
+	group            *group.Group
+	polynomialDegree int
+
+	// Used only to verify shares against commitments.
+	verifier *CommittingMember
+}
+
+// VerifyTranscript replays the key generation recorded in the transcript and
+// checks whether the group public key it contains was correctly derived from
+// the broadcast messages.
+//
+// The QUAL set is derived by replaying phases 1 to 5 of the protocol: members
+// which did not broadcast their messages are marked as inactive, members which
+// broadcast invalid messages are disqualified and accusations published in
+// phase 4 are resolved against revealed shares and commitments. The derived
+// set has to match the one in the transcript.
+//
+// The group public key is the sum of individual public keys `y_j = A_j0` of
+// all QUAL members. Individual public keys of QUAL members which did not
+// broadcast valid public key share points in phase 7 or whose points have been
+// proven invalid in phase 9 are reconstructed from shares revealed in
+// phase 10, as specified in phase 11 of the protocol. Only revealed shares
+// valid against commitments of the misbehaved member are interpolated.
+//
+// Signatures of accusations are not verified; messages are assumed to come
+// from members whose IDs they declare.
+func VerifyTranscript(transcript *Transcript) error {
+	if len(transcript.GroupPublicKey) == 0 {
+		return fmt.Errorf(
+			"%w: transcript has no group public key",
+			ErrInsufficientShares,
+		)
+	}
+	if transcript.Seed == nil ||
+		transcript.GroupSize < 1 ||
+		transcript.GroupSize > group.MaxMemberIndex {
+		return fmt.Errorf(
+			"%w: transcript has no seed or an invalid group size [%v]",
+			ErrVerificationFailed,
+			transcript.GroupSize,
+		)
+	}
+
+	groupPublicKey := new(bn256.G2)
+	if _, err := groupPublicKey.Unmarshal(transcript.GroupPublicKey); err != nil {
+		return fmt.Errorf("could not unmarshal group public key: [%v]", err)
+	}
+
+	messages, err := unmarshalTranscriptMessages(transcript.Messages)
+	if err != nil {
+		return err
+	}
+
+	replay := &transcriptReplay{
+		messages: messages,
+		group: group.NewDkgGroup(
+			transcript.DishonestThreshold,
+			transcript.GroupSize,
+		),
+		polynomialDegree: transcript.PolynomialDegree,
+		verifier: &CommittingMember{
+			SymmetricKeyGeneratingMember: &SymmetricKeyGeneratingMember{
+				EphemeralKeyPairGeneratingMember: &EphemeralKeyPairGeneratingMember{
+					LocalMember: &LocalMember{
+						memberCore: &memberCore{
+							protocolParameters: newProtocolParameters(
+								transcript.Seed,
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	qualifiedMemberIDs := replay.qualifiedMembers()
+	if !reflect.DeepEqual(qualifiedMemberIDs, transcript.QualifiedMemberIDs) {
+		return fmt.Errorf(
+			"%w: qualified members [%v] do not match members [%v] "+
+				"derived from the transcript",
+			ErrVerificationFailed,
+			transcript.QualifiedMemberIDs,
+			qualifiedMemberIDs,
+		)
+	}
+
+	misbehaved := replay.misbehavedQualifiedMembers(qualifiedMemberIDs)
+
+	var expectedGroupPublicKey *bn256.G2
+	for _, memberID := range qualifiedMemberIDs {
+		var individualPublicKey *bn256.G2
+
+		if misbehaved[memberID] {
+			individualPrivateKey, err := replay.reconstructIndividualPrivateKey(
+				memberID,
+			)
+			if err != nil {
+				return err
+			}
+			individualPublicKey = new(bn256.G2).ScalarBaseMult(
+				individualPrivateKey,
+			)
+		} else {
+			individualPublicKey =
+				messages.publicKeySharePoints[memberID].publicKeySharePoints[0]
+		}
+
+		if expectedGroupPublicKey == nil {
+			expectedGroupPublicKey = individualPublicKey
+		} else {
+			expectedGroupPublicKey = new(bn256.G2).Add(
+				expectedGroupPublicKey,
+				individualPublicKey,
+			)
+		}
+	}
+
+	if expectedGroupPublicKey == nil ||
+		!constantTimeG2Equal(expectedGroupPublicKey, groupPublicKey) {
+		return fmt.Errorf(
+			"%w: group public key does not match the transcript",
+			ErrVerificationFailed,
+		)
+	}
+
+	return nil
+}
+
+func unmarshalTranscriptMessages(
+	messages []*TranscriptMessage,
+) (*transcriptMessages, error) {
+	result := &transcriptMessages{
+		ephemeralPublicKeys:  make(map[group.MemberIndex]*EphemeralPublicKeyMessage),
+		commitments:          make(map[group.MemberIndex]*MemberCommitmentsMessage),
+		peerShares:           make(map[group.MemberIndex]*PeerSharesMessage),
+		sharesAccusations:    make(map[group.MemberIndex]*SecretSharesAccusationsMessage),
+		publicKeySharePoints: make(map[group.MemberIndex]*MemberPublicKeySharePointsMessage),
+		pointsAccusations:    make(map[group.MemberIndex]*PointsAccusationsMessage),
+	}
+	misbehavedKeysSenders := make(map[group.MemberIndex]bool)
+
+	for i, message := range messages {
+		var unmarshaler net.TaggedUnmarshaler
+		switch message.Type {
+		case (&EphemeralPublicKeyMessage{}).Type():
+			unmarshaler = &EphemeralPublicKeyMessage{}
+		case (&MemberCommitmentsMessage{}).Type():
+			unmarshaler = &MemberCommitmentsMessage{}
+		case (&PeerSharesMessage{}).Type():
+			unmarshaler = &PeerSharesMessage{}
+		case (&SecretSharesAccusationsMessage{}).Type():
+			unmarshaler = &SecretSharesAccusationsMessage{}
+		case (&MemberPublicKeySharePointsMessage{}).Type():
+			unmarshaler = &MemberPublicKeySharePointsMessage{}
+		case (&PointsAccusationsMessage{}).Type():
+			unmarshaler = &PointsAccusationsMessage{}
+		case (&MisbehavedEphemeralKeysMessage{}).Type():
+			unmarshaler = &MisbehavedEphemeralKeysMessage{}
+		default:
+			continue
+		}
+
+		if err := unmarshaler.Unmarshal(message.Payload); err != nil {
+			return nil, fmt.Errorf(
+				"could not unmarshal transcript message [%v] of type [%v]: [%v]",
+				i,
+				message.Type,
+				err,
+			)
+		}
+
+		switch m := unmarshaler.(type) {
+		case *EphemeralPublicKeyMessage:
+			if _, ok := result.ephemeralPublicKeys[m.senderID]; !ok {
+				result.ephemeralPublicKeys[m.senderID] = m
+			}
+		case *MemberCommitmentsMessage:
+			if _, ok := result.commitments[m.senderID]; !ok {
+				result.commitments[m.senderID] = m
+			}
+		case *PeerSharesMessage:
+			if _, ok := result.peerShares[m.senderID]; !ok {
+				result.peerShares[m.senderID] = m
+			}
+		case *SecretSharesAccusationsMessage:
+			if _, ok := result.sharesAccusations[m.senderID]; !ok {
+				result.sharesAccusations[m.senderID] = m
+			}
+		case *MemberPublicKeySharePointsMessage:
+			if _, ok := result.publicKeySharePoints[m.senderID]; !ok {
+				result.publicKeySharePoints[m.senderID] = m
+			}
+		case *PointsAccusationsMessage:
+			if _, ok := result.pointsAccusations[m.senderID]; !ok {
+				result.pointsAccusations[m.senderID] = m
+			}
+		case *MisbehavedEphemeralKeysMessage:
+			if !misbehavedKeysSenders[m.senderID] {
+				misbehavedKeysSenders[m.senderID] = true
+				result.misbehavedKeys = append(result.misbehavedKeys, m)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// qualifiedMembers replays phases 1 to 5 of the protocol and returns IDs of
+// members in the QUAL set, ordered by member index. Unlike members, a third
+// party has no shares of its own, so it relies on accusations published in
+// phase 4 to learn about invalid shares.
+func (tr *transcriptReplay) qualifiedMembers() []group.MemberIndex {
+	// Phase 2: ephemeral public keys.
+	for _, memberID := range tr.group.MemberIDs() {
+		message, ok := tr.messages.ephemeralPublicKeys[memberID]
+		if !ok {
+			tr.group.MarkMemberAsInactive(memberID)
+			continue
+		}
+		if !tr.isValidEphemeralPublicKeyMessage(message) {
+			tr.group.MarkMemberAsDisqualified(memberID)
+		}
+	}
+
+	// Phase 4: shares and commitments.
+	for _, memberID := range tr.group.OperatingMemberIDs() {
+		_, hasShares := tr.messages.peerShares[memberID]
+		_, hasCommitments := tr.messages.commitments[memberID]
+		if !hasShares || !hasCommitments {
+			tr.group.MarkMemberAsInactive(memberID)
+		}
+	}
+	for _, memberID := range tr.group.OperatingMemberIDs() {
+		if !tr.isValidCommitmentsMessage(tr.messages.commitments[memberID]) ||
+			!tr.isValidPeerSharesMessage(tr.messages.peerShares[memberID]) {
+			tr.group.MarkMemberAsDisqualified(memberID)
+		}
+	}
+
+	qualified := make(map[group.MemberIndex]bool)
+	for _, memberID := range tr.group.OperatingMemberIDs() {
+		qualified[memberID] = true
+	}
+
+	// Phase 5: secret shares accusations. Members inactive in this phase
+	// stay in QUAL since their shares are still valid.
+	accuserIDs := tr.group.OperatingMemberIDs()
+	for _, memberID := range accuserIDs {
+		if _, ok := tr.messages.sharesAccusations[memberID]; !ok {
+			tr.group.MarkMemberAsInactive(memberID)
+		}
+	}
+	for _, accuserID := range accuserIDs {
+		message, ok := tr.messages.sharesAccusations[accuserID]
+		if !ok {
+			continue
+		}
+
+		for _, accusedID := range accusedMemberIDs(message.accusedMembersKeys) {
+			if accusedID == accuserID {
+				continue
+			}
+
+			shareS, shareT, ok := tr.revealedShares(
+				accuserID,
+				accusedID,
+				message.accusedMembersKeys[accusedID],
+			)
+
+			misbehavingID := accusedID
+			if !ok {
+				misbehavingID = accuserID
+			} else if shareS != nil && tr.verifier.areSharesValidAgainstCommitments(
+				shareS,
+				shareT,
+				tr.validCommitments(accusedID),
+				accuserID,
+			) {
+				misbehavingID = accuserID
+			}
+
+			tr.group.MarkMemberAsDisqualified(misbehavingID)
+			delete(qualified, misbehavingID)
+		}
+	}
+
+	var qualifiedMemberIDs []group.MemberIndex
+	for memberID := range qualified {
+		qualifiedMemberIDs = append(qualifiedMemberIDs, memberID)
+	}
+	group.SortMemberIndexes(qualifiedMemberIDs)
+
+	return qualifiedMemberIDs
+}
+
+// misbehavedQualifiedMembers replays phases 7 to 9 of the protocol and
+// returns QUAL members whose individual public keys have to be reconstructed.
+// Those are QUAL members which did not broadcast valid public key share
+// points in phase 7 and members whose points have been proven inconsistent
+// with their shares in phase 9.
+func (tr *transcriptReplay) misbehavedQualifiedMembers(
+	qualifiedMemberIDs []group.MemberIndex,
+) map[group.MemberIndex]bool {
+	// Phase 8: public key share points. Points of members which are not
+	// operating anymore are not accepted.
+	validPoints := make(map[group.MemberIndex]bool)
+	for _, memberID := range tr.group.OperatingMemberIDs() {
+		message, ok := tr.messages.publicKeySharePoints[memberID]
+		if !ok {
+			tr.group.MarkMemberAsInactive(memberID)
+			continue
+		}
+		if len(message.publicKeySharePoints) != tr.polynomialDegree+1 {
+			tr.group.MarkMemberAsDisqualified(memberID)
+			continue
+		}
+		validPoints[memberID] = true
+	}
+
+	misbehaved := make(map[group.MemberIndex]bool)
+	for _, memberID := range qualifiedMemberIDs {
+		if !validPoints[memberID] {
+			misbehaved[memberID] = true
+		}
+	}
+
+	// Phase 9: points accusations.
+	for _, accuserID := range tr.group.OperatingMemberIDs() {
+		message, ok := tr.messages.pointsAccusations[accuserID]
+		if !ok {
+			continue
+		}
+
+		for _, accusedID := range accusedMemberIDs(message.accusedMembersKeys) {
+			if accusedID == accuserID || !validPoints[accusedID] {
+				continue
+			}
+
+			shareS, _, ok := tr.revealedShares(
+				accuserID,
+				accusedID,
+				message.accusedMembersKeys[accusedID],
+			)
+			if !ok || shareS == nil {
+				continue
+			}
+
+			if !(&SharingMember{}).isShareValidAgainstPublicKeySharePoints(
+				accuserID,
+				shareS,
+				tr.messages.publicKeySharePoints[accusedID].publicKeySharePoints,
+			) {
+				misbehaved[accusedID] = true
+			}
+		}
+	}
+
+	return misbehaved
+}
+
+// revealedShares decrypts shares `s_mj` and `t_mj` the accused member `m`
+// sent to the accuser `j`, using the ephemeral private key revealed by the
+// accuser. The last return value is false if the accusation can not be
+// resolved against the accused member: the revealed key does not match
+// the accuser's public key or the accused member did not broadcast its
+// ephemeral public key or shares. If the shares can not be decrypted, nil
+// shares are returned along with true, since the accused member is the one
+// who misbehaved.
+func (tr *transcriptReplay) revealedShares(
+	accuserID, accusedID group.MemberIndex,
+	revealedPrivateKey *ephemeral.PrivateKey,
+) (*big.Int, *big.Int, bool) {
+	accuserPublicKey := tr.ephemeralPublicKey(accuserID, accusedID)
+	if accuserPublicKey == nil ||
+		revealedPrivateKey == nil ||
+		!accuserPublicKey.IsKeyMatching(revealedPrivateKey) {
+		return nil, nil, false
+	}
+
+	accusedPublicKey := tr.ephemeralPublicKey(accusedID, accuserID)
+	if accusedPublicKey == nil {
+		return nil, nil, false
+	}
+
+	sharesMessage, ok := tr.messages.peerShares[accusedID]
+	if !ok {
+		return nil, nil, false
+	}
+
+	shareS, shareT, err := sharesMessage.decryptShares(
+		accuserID,
+		revealedPrivateKey.Ecdh(accusedPublicKey),
+	)
+	if err != nil {
+		return nil, nil, true
+	}
+
+	return shareS, shareT, true
+}
+
+// ephemeralPublicKey returns the ephemeral public key generated by the sender
+// for the receiver, or nil if the sender did not broadcast a valid ephemeral
+// public key message.
+func (tr *transcriptReplay) ephemeralPublicKey(
+	senderID, receiverID group.MemberIndex,
+) *ephemeral.PublicKey {
+	message, ok := tr.messages.ephemeralPublicKeys[senderID]
+	if !ok || !tr.isValidEphemeralPublicKeyMessage(message) {
+		return nil
+	}
+
+	return message.ephemeralPublicKeys[receiverID]
+}
+
+func (tr *transcriptReplay) isValidEphemeralPublicKeyMessage(
+	message *EphemeralPublicKeyMessage,
+) bool {
+	for _, memberID := range tr.group.MemberIDs() {
+		if _, ok := message.ephemeralPublicKeys[memberID]; !ok &&
+			memberID != message.senderID {
+			return false
+		}
+	}
+
+	return len(message.ephemeralPublicKeys) == tr.group.GroupSize()-1
+}
+
+// validCommitments returns commitments of the given member or nil if the
+// member did not broadcast a valid commitments message.
+func (tr *transcriptReplay) validCommitments(
+	memberID group.MemberIndex,
+) []*bn256.G1 {
+	message, ok := tr.messages.commitments[memberID]
+	if !ok || !tr.isValidCommitmentsMessage(message) {
+		return nil
+	}
+
+	return message.commitments
+}
+
+func (tr *transcriptReplay) isValidCommitmentsMessage(
+	message *MemberCommitmentsMessage,
+) bool {
+	return len(message.commitments) == tr.polynomialDegree+1
+}
+
+func (tr *transcriptReplay) isValidPeerSharesMessage(
+	message *PeerSharesMessage,
+) bool {
+	for receiverID := range message.shares {
+		if receiverID == message.senderID ||
+			receiverID < 1 ||
+			int(receiverID) > tr.group.GroupSize() {
+			return false
+		}
+	}
+
+	for _, memberID := range tr.group.OperatingMemberIDs() {
+		if _, ok := message.shares[memberID]; !ok &&
+			memberID != message.senderID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reconstructIndividualPrivateKey reconstructs individual private key `z_m` of
+// the misbehaved member `m` from shares `s_mk` revealed by peer members `k`.
+// Shares which are not valid against commitments of the misbehaved member are
+// skipped. The same as members do, the key is interpolated from the valid
+// shares revealed by the polynomial degree + 1 members with the lowest IDs.
+func (tr *transcriptReplay) reconstructIndividualPrivateKey(
+	misbehavedMemberID group.MemberIndex,
+) (*big.Int, error) {
+	commitments := tr.validCommitments(misbehavedMemberID)
+
+	peerSharesS := make(map[group.MemberIndex]*big.Int)
+	for _, message := range tr.messages.misbehavedKeys {
+		revealingMemberID := message.senderID
+
+		revealedPrivateKey, ok := message.privateKeys[misbehavedMemberID]
+		if !ok {
+			continue
+		}
+
+		shareS, shareT, ok := tr.revealedShares(
+			revealingMemberID,
+			misbehavedMemberID,
+			revealedPrivateKey,
+		)
+		if !ok || shareS == nil {
+			continue
+		}
+
+		if !tr.verifier.areSharesValidAgainstCommitments(
+			shareS,
+			shareT,
+			commitments,
+			revealingMemberID,
+		) {
+			logger.Warningf(
+				"share of misbehaved member [%v] revealed by member [%v] "+
+					"is not valid against commitments",
+				misbehavedMemberID,
+				revealingMemberID,
+			)
+			continue
+		}
+
+		peerSharesS[revealingMemberID] = shareS
+	}
+
+	requiredSharesCount := tr.polynomialDegree + 1
+	if len(peerSharesS) < requiredSharesCount {
+		return nil, fmt.Errorf(
+			"%w: only [%v] valid shares of misbehaved member [%v] revealed; "+
+				"[%v] are required for reconstruction",
+			ErrVerificationFailed,
+			len(peerSharesS),
+			misbehavedMemberID,
+			requiredSharesCount,
+		)
+	}
+
+	peerIDs := make([]group.MemberIndex, 0, len(peerSharesS))
+	for peerID := range peerSharesS {
+		peerIDs = append(peerIDs, peerID)
+	}
+	group.SortMemberIndexes(peerIDs)
+
+	return interpolateShare(0, peerSharesS, peerIDs[:requiredSharesCount]), nil
+}
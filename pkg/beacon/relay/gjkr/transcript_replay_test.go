@@ -0,0 +1,102 @@
+package gjkr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+)
+
+func TestTranscriptReplayReconstructionSkipsInvalidShares(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 4
+
+	misbehavedMemberID := group.MemberIndex(4)
+	tamperedShareReceiverID := group.MemberIndex(1)
+
+	members, err := initializeCommittingMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := &transcriptMessages{
+		ephemeralPublicKeys: make(map[group.MemberIndex]*EphemeralPublicKeyMessage),
+		commitments:         make(map[group.MemberIndex]*MemberCommitmentsMessage),
+		peerShares:          make(map[group.MemberIndex]*PeerSharesMessage),
+	}
+	for _, member := range members {
+		sharesMessage, commitmentsMessage, err :=
+			member.CalculateMembersSharesAndCommitments()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		messages.ephemeralPublicKeys[member.ID] =
+			member.evidenceLog.ephemeralPublicKeyMessage(member.ID)
+		messages.peerShares[member.ID] = sharesMessage
+		messages.commitments[member.ID] = commitmentsMessage
+	}
+
+	// The misbehaved member sends to the member with the lowest ID a share
+	// inconsistent with its commitments. Shares revealed by that member
+	// would be interpolated if they were not verified.
+	misbehavedMember := members[misbehavedMemberID-1]
+	symmetricKey := misbehavedMember.symmetricKeys[tamperedShareReceiverID]
+	sharesMessage := messages.peerShares[misbehavedMemberID]
+	shareS, shareT, err := sharesMessage.decryptShares(
+		tamperedShareReceiverID,
+		symmetricKey,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sharesMessage.addShares(
+		tamperedShareReceiverID,
+		new(big.Int).Add(shareS, big.NewInt(1)),
+		shareT,
+		symmetricKey,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, member := range members {
+		if member.ID == misbehavedMemberID {
+			continue
+		}
+
+		messages.misbehavedKeys = append(
+			messages.misbehavedKeys,
+			&MisbehavedEphemeralKeysMessage{
+				senderID: member.ID,
+				privateKeys: map[group.MemberIndex]*ephemeral.PrivateKey{
+					misbehavedMemberID: member.ephemeralKeyPairs[misbehavedMemberID].PrivateKey,
+				},
+			},
+		)
+	}
+
+	replay := &transcriptReplay{
+		messages:         messages,
+		group:            group.NewDkgGroup(dishonestThreshold, groupSize),
+		polynomialDegree: dishonestThreshold,
+		verifier:         members[0],
+	}
+
+	individualPrivateKey, err := replay.reconstructIndividualPrivateKey(
+		misbehavedMemberID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPrivateKey := misbehavedMember.secretCoefficients[0]
+	if individualPrivateKey.Cmp(expectedPrivateKey) != 0 {
+		t.Fatalf(
+			"unexpected individual private key\nexpected: %v\nactual:   %v\n",
+			expectedPrivateKey,
+			individualPrivateKey,
+		)
+	}
+}
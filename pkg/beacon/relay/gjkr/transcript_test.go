@@ -0,0 +1,225 @@
+package gjkr_test
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/internal/dkgtest"
+	"github.com/keep-network/keep-core/pkg/net/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+func TestExecute_Transcript(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 3
+	honestThreshold := 2
+	dishonestThreshold := groupSize - honestThreshold
+	seed := dkgtest.RandomSeed(t)
+
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	chain := chainLocal.ConnectWithKey(
+		groupSize,
+		honestThreshold,
+		big.NewInt(20),
+		privateKey,
+	)
+	blockCounter, err := chain.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channel, err := netLocal.ConnectWithKey(networkPublicKey).BroadcastChannelFor(
+		fmt.Sprintf("gjkr-transcript-test-%v", seed),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gjkr.RegisterUnmarshallers(channel)
+
+	address := chain.Signing().PublicKeyBytesToAddress(
+		key.Marshal(networkPublicKey),
+	)
+	selectedStakers := make([]relaychain.StakerAddress, groupSize)
+	for i := range selectedStakers {
+		selectedStakers[i] = address
+	}
+	membershipValidator := group.NewStakersMembershipValidator(
+		selectedStakers,
+		chain.Signing(),
+	)
+
+	currentBlockHeight, err := blockCounter.CurrentBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	startBlockHeight := currentBlockHeight + 3
+
+	results := make([]*gjkr.Result, groupSize)
+	errs := make([]error, groupSize)
+
+	var wg sync.WaitGroup
+	wg.Add(groupSize)
+	for i := 0; i < groupSize; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			results[i], _, errs[i] = gjkr.Execute(
+				context.Background(),
+				group.MemberIndex(i+1),
+				groupSize,
+				blockCounter,
+				channel,
+				dishonestThreshold,
+				0,
+				seed,
+				membershipValidator,
+				chain.Signing(),
+				startBlockHeight,
+				nil,
+				nil,
+			)
+		}()
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		memberID := group.MemberIndex(i + 1)
+
+		if errs[i] != nil {
+			t.Fatalf("member [%v] failed: [%v]", memberID, errs[i])
+		}
+
+		// The transcript is expected to survive serialization so that it
+		// can be handed over to a third party.
+		serialized, err := json.Marshal(result.Transcript)
+		if err != nil {
+			t.Fatal(err)
+		}
+		transcript := &gjkr.Transcript{}
+		if err := json.Unmarshal(serialized, transcript); err != nil {
+			t.Fatal(err)
+		}
+
+		// Each member broadcasts seven messages in a clean run.
+		expectedMessagesCount := 7 * groupSize
+		if len(transcript.Messages) != expectedMessagesCount {
+			t.Errorf(
+				"unexpected number of messages in the transcript of member [%v]"+
+					"\nexpected: %v\nactual:   %v\n",
+				memberID,
+				expectedMessagesCount,
+				len(transcript.Messages),
+			)
+		}
+
+		if len(transcript.QualifiedMemberIDs) != groupSize {
+			t.Errorf(
+				"unexpected number of qualified members\nexpected: %v\nactual:   %v\n",
+				groupSize,
+				len(transcript.QualifiedMemberIDs),
+			)
+		}
+
+		if err := gjkr.VerifyTranscript(transcript); err != nil {
+			t.Errorf(
+				"transcript of member [%v] not verified: [%v]",
+				memberID,
+				err,
+			)
+		}
+
+		// The QUAL set is derived from the transcript messages, so it can not
+		// be altered.
+		qualifiedMemberIDs := transcript.QualifiedMemberIDs
+		transcript.QualifiedMemberIDs = qualifiedMemberIDs[1:]
+		err = gjkr.VerifyTranscript(transcript)
+		if !errors.Is(err, gjkr.ErrVerificationFailed) {
+			t.Errorf(
+				"unexpected error for transcript of member [%v] with "+
+					"altered qualified members\nexpected: %v\nactual:   %v\n",
+				memberID,
+				gjkr.ErrVerificationFailed,
+				err,
+			)
+		}
+		transcript.QualifiedMemberIDs = qualifiedMemberIDs
+
+		// A false accusation is resolved while replaying the transcript and
+		// gets the accuser disqualified, so the QUAL set in the transcript
+		// is not confirmed anymore.
+		accusationsType := (&gjkr.SecretSharesAccusationsMessage{}).Type()
+		for _, message := range transcript.Messages {
+			if message.Type != accusationsType ||
+				message.SenderID != group.MemberIndex(1) {
+				continue
+			}
+
+			accusationsMessage := &gjkr.SecretSharesAccusationsMessage{}
+			if err := accusationsMessage.Unmarshal(message.Payload); err != nil {
+				t.Fatal(err)
+			}
+			keyPair, err := ephemeral.GenerateKeyPair(crand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			accusationsMessage.SetAccusedMemberKeys(
+				map[group.MemberIndex]*ephemeral.PrivateKey{
+					group.MemberIndex(2): keyPair.PrivateKey,
+				},
+			)
+
+			originalPayload := message.Payload
+			message.Payload, err = accusationsMessage.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = gjkr.VerifyTranscript(transcript)
+			if !errors.Is(err, gjkr.ErrVerificationFailed) {
+				t.Errorf(
+					"unexpected error for transcript of member [%v] with "+
+						"false accusation\nexpected: %v\nactual:   %v\n",
+					memberID,
+					gjkr.ErrVerificationFailed,
+					err,
+				)
+			}
+
+			message.Payload = originalPayload
+		}
+
+		// Any other group public key must not be confirmed by the transcript.
+		transcript.GroupPublicKey = new(bn256.G2).ScalarBaseMult(
+			big.NewInt(1),
+		).Marshal()
+		err = gjkr.VerifyTranscript(transcript)
+		if !errors.Is(err, gjkr.ErrVerificationFailed) {
+			t.Errorf(
+				"unexpected error for tampered transcript of member [%v]"+
+					"\nexpected: %v\nactual:   %v\n",
+				memberID,
+				gjkr.ErrVerificationFailed,
+				err,
+			)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package gjkr
+
+import (
+	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
+	"github.com/keep-network/keep-core/pkg/internal/wipe"
+)
+
+// Secret values held by members are overwritten with zeros once the last
+// protocol phase using them completes so that they do not linger in memory
+// until the garbage collector frees them. All remaining secrets are wiped once
+// the protocol completes. Wiping is best-effort only, see the wipe package.
+// Also, values derived from secrets and handed over outside the member, like
+// the group private key share returned in the result, are not wiped.
+
+// Wipe overwrites ephemeral private keys generated by the member with zeros.
+func (ekgm *EphemeralKeyPairGeneratingMember) Wipe() {
+	for _, keyPair := range ekgm.ephemeralKeyPairs {
+		if keyPair.PrivateKey != nil {
+			keyPair.PrivateKey.Zeroize()
+		}
+	}
+}
+
+// Wipe overwrites secret polynomial coefficients and shares the member
+// calculated for itself with zeros, along with all secrets of the previous
+// protocol phases.
+func (cm *CommittingMember) Wipe() {
+	cm.SymmetricKeyGeneratingMember.Wipe()
+
+	cm.wipeSecretCoefficients()
+	cm.wipeSelfSecretShares()
+}
+
+// wipeSecretCoefficients overwrites secret polynomial coefficients with zeros.
+// They are last used in phase 7 to calculate public key share points.
+func (cm *CommittingMember) wipeSecretCoefficients() {
+	for _, coefficient := range cm.secretCoefficients {
+		wipe.Int(coefficient)
+	}
+}
+
+// wipeSelfSecretShares overwrites shares the member calculated for itself
+// with zeros. They are last used in phase 6 to combine member shares.
+func (cm *CommittingMember) wipeSelfSecretShares() {
+	wipe.Int(cm.selfSecretShareS)
+	wipe.Int(cm.selfSecretShareT)
+}
+
+// Wipe overwrites shares received from peer members with zeros, along with
+// all secrets of the previous protocol phases. The map keys are left intact
+// as they are used to determine the QUAL set.
+func (cvm *CommitmentsVerifyingMember) Wipe() {
+	cvm.CommittingMember.Wipe()
+
+	cvm.wipeReceivedShares()
+}
+
+// wipeReceivedShares overwrites shares received from peer members with zeros.
+// They are last used in phase 11 to reconstruct individual keys of misbehaved
+// members. The map keys are left intact as they are used to determine the QUAL
+// set.
+func (cvm *CommitmentsVerifyingMember) wipeReceivedShares() {
+	for _, share := range cvm.receivedQualifiedSharesS {
+		wipe.Int(share)
+	}
+	for _, share := range cvm.receivedQualifiedSharesT {
+		wipe.Int(share)
+	}
+}
+
+// Wipe overwrites member's share of the group private key with zeros, along
+// with all secrets of the previous protocol phases.
+func (qm *QualifiedMember) Wipe() {
+	qm.SharesJustifyingMember.Wipe()
+
+	wipe.Int(qm.groupPrivateKeyShare)
+}
+
+// Wipe overwrites individual private keys reconstructed for misbehaved
+// members with zeros, along with all secrets of the previous protocol phases.
+//
+// Revealed shares of misbehaved members are not wiped since they are used
+// by the asynchronous group public key shares computation started in phase 12.
+func (rm *ReconstructingMember) Wipe() {
+	rm.RevealingMember.Wipe()
+
+	rm.wipeReconstructedIndividualPrivateKeys()
+}
+
+// wipeReconstructedIndividualPrivateKeys overwrites individual private keys
+// reconstructed for misbehaved members with zeros. They are used only in
+// phase 11 to reconstruct individual public keys.
+func (rm *ReconstructingMember) wipeReconstructedIndividualPrivateKeys() {
+	for _, privateKey := range rm.reconstructedIndividualPrivateKeys {
+		wipe.Int(privateKey)
+	}
+}
+
+// wipeStateSecrets wipes secrets of the member executing the given protocol
+// state. It is used when the protocol execution is abandoned before reaching
+// the final state, in which case secrets are not wiped upon the completion.
+func wipeStateSecrets(currentState state.State) {
+	switch s := currentState.(type) {
+	case *ephemeralKeyPairGenerationState:
+		s.member.Wipe()
+	case *symmetricKeyGenerationState:
+		s.member.Wipe()
+	case *commitmentState:
+		s.member.Wipe()
+	case *commitmentsVerificationState:
+		s.member.Wipe()
+	case *sharesJustificationState:
+		s.member.Wipe()
+	case *qualificationState:
+		s.member.Wipe()
+	case *pointsShareState:
+		s.member.Wipe()
+	case *pointsValidationState:
+		s.member.Wipe()
+	case *pointsJustificationState:
+		s.member.Wipe()
+	case *keyRevealState:
+		s.member.Wipe()
+	case *reconstructionState:
+		s.member.Wipe()
+	case *combinationState:
+		s.member.Wipe()
+	case *finalizationState:
+		s.member.Wipe()
+	}
+}
@@ -0,0 +1,195 @@
+package gjkr
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestWipe(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializeCombiningMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := members[0]
+	member.selfSecretShareS = big.NewInt(81)
+	member.selfSecretShareT = big.NewInt(97)
+	member.CombineMemberShares()
+	member.reconstructedIndividualPrivateKeys[5] = big.NewInt(1410)
+
+	finalizingMember := member.InitializeFinalization()
+
+	expectedGroupPrivateKeyShare := new(big.Int).Set(
+		finalizingMember.groupPrivateKeyShare,
+	)
+	receivedSharesCount := len(finalizingMember.receivedQualifiedSharesS)
+	result := finalizingMember.Result()
+
+	finalizingMember.Wipe()
+
+	assertWiped := func(name string, value *big.Int) {
+		if value.Sign() != 0 {
+			t.Errorf("%v not wiped: [%v]", name, value)
+		}
+		for _, word := range value.Bits() {
+			if word != 0 {
+				t.Errorf("%v backing words not wiped", name)
+			}
+		}
+	}
+
+	for i, keyPair := range finalizingMember.ephemeralKeyPairs {
+		assertWiped("ephemeral private key", keyPair.PrivateKey.D)
+		if i == finalizingMember.ID {
+			t.Errorf("unexpected ephemeral key pair generated for self")
+		}
+	}
+	for _, coefficient := range finalizingMember.secretCoefficients {
+		assertWiped("secret coefficient", coefficient)
+	}
+	assertWiped("self secret share S", finalizingMember.selfSecretShareS)
+	assertWiped("self secret share T", finalizingMember.selfSecretShareT)
+	for _, share := range finalizingMember.receivedQualifiedSharesS {
+		assertWiped("received share S", share)
+	}
+	for _, share := range finalizingMember.receivedQualifiedSharesT {
+		assertWiped("received share T", share)
+	}
+	assertWiped("group private key share", finalizingMember.groupPrivateKeyShare)
+	for _, privateKey := range finalizingMember.reconstructedIndividualPrivateKeys {
+		assertWiped("reconstructed individual private key", privateKey)
+	}
+
+	if len(finalizingMember.receivedQualifiedSharesS) != receivedSharesCount {
+		t.Errorf(
+			"unexpected number of received shares\nexpected: %v\nactual:   %v\n",
+			receivedSharesCount,
+			len(finalizingMember.receivedQualifiedSharesS),
+		)
+	}
+
+	if result.GroupPrivateKeyShare.Cmp(expectedGroupPrivateKeyShare) != 0 {
+		t.Errorf(
+			"unexpected group private key share in result\n"+
+				"expected: %v\nactual:   %v\n",
+			expectedGroupPrivateKeyShare,
+			result.GroupPrivateKeyShare,
+		)
+	}
+}
+
+func TestWipeAfterLastUsingPhase(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializeCombiningMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := members[0]
+	member.selfSecretShareS = big.NewInt(81)
+	member.selfSecretShareT = big.NewInt(97)
+	member.reconstructedIndividualPrivateKeys[5] = big.NewInt(1410)
+	receivedSharesCount := len(member.receivedQualifiedSharesS)
+
+	isWiped := func(values ...*big.Int) bool {
+		for _, value := range values {
+			if value.Sign() != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	ephemeralPrivateKeys := func() []*big.Int {
+		var keys []*big.Int
+		for _, keyPair := range member.ephemeralKeyPairs {
+			keys = append(keys, keyPair.PrivateKey.D)
+		}
+		return keys
+	}
+	receivedSharesS := func() []*big.Int {
+		var shares []*big.Int
+		for _, share := range member.receivedQualifiedSharesS {
+			shares = append(shares, share)
+		}
+		return shares
+	}
+
+	(&qualificationState{member: member.QualifiedMember}).Next()
+
+	if !isWiped(member.selfSecretShareS, member.selfSecretShareT) {
+		t.Errorf("self secret shares not wiped after phase 6")
+	}
+	if isWiped(member.secretCoefficients...) {
+		t.Errorf("secret coefficients wiped before phase 7")
+	}
+
+	(&pointsShareState{member: member.SharingMember}).Next()
+
+	if !isWiped(member.secretCoefficients...) {
+		t.Errorf("secret coefficients not wiped after phase 7")
+	}
+	if isWiped(ephemeralPrivateKeys()...) {
+		t.Errorf("ephemeral private keys wiped before phase 10")
+	}
+
+	(&keyRevealState{member: member.RevealingMember}).Next()
+
+	if !isWiped(ephemeralPrivateKeys()...) {
+		t.Errorf("ephemeral private keys not wiped after phase 10")
+	}
+	if isWiped(receivedSharesS()...) {
+		t.Errorf("received shares wiped before phase 11")
+	}
+
+	(&reconstructionState{member: member.ReconstructingMember}).Next()
+
+	if !isWiped(receivedSharesS()...) {
+		t.Errorf("received shares not wiped after phase 11")
+	}
+	if !isWiped(member.reconstructedIndividualPrivateKeys[5]) {
+		t.Errorf("reconstructed individual private keys not wiped after phase 11")
+	}
+	if len(member.receivedQualifiedSharesS) != receivedSharesCount {
+		t.Errorf(
+			"unexpected number of received shares\nexpected: %v\nactual:   %v\n",
+			receivedSharesCount,
+			len(member.receivedQualifiedSharesS),
+		)
+	}
+}
+
+func TestWipeStateSecrets(t *testing.T) {
+	dishonestThreshold := 2
+	groupSize := 5
+
+	members, err := initializeCommittingMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := members[0]
+	if _, _, err := member.CalculateMembersSharesAndCommitments(); err != nil {
+		t.Fatal(err)
+	}
+
+	wipeStateSecrets(&commitmentState{member: member})
+
+	for _, keyPair := range member.ephemeralKeyPairs {
+		if keyPair.PrivateKey.D.Sign() != 0 {
+			t.Errorf("ephemeral private key not wiped")
+		}
+	}
+	for _, coefficient := range member.secretCoefficients {
+		if coefficient.Sign() != 0 {
+			t.Errorf("secret coefficient not wiped")
+		}
+	}
+	if member.selfSecretShareS.Sign() != 0 {
+		t.Errorf("self secret share S not wiped")
+	}
+}
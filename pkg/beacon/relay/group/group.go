@@ -14,7 +14,9 @@ type Group struct {
 }
 
 // NewDkgGroup creates a new Group with the provided dishonest threshold, member
-// identifiers, and empty IA and DQ members list.
+// identifiers, and empty IA and DQ members list. Member identifiers start at 1.
+// Size must not exceed 255 since greater indices overflow MemberIndex and
+// the last member would get an index of 0.
 func NewDkgGroup(dishonestThreshold int, size int) *Group {
 	memberIDs := make([]MemberIndex, size)
 	for i := 0; i < size; i++ {
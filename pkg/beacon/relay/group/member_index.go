@@ -1,5 +1,47 @@
 package group
 
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
 // MemberIndex is an index of a member in a group. The maximum member index
 // value is 255.
 type MemberIndex = uint8
+
+// MaxMemberIndex is the maximum value of a member index.
+const MaxMemberIndex = 255
+
+// MemberIndexToBigInt returns the member index as a big integer. Protocols
+// use the member index as the point at which member's share of a secret
+// polynomial is evaluated.
+func MemberIndexToBigInt(memberIndex MemberIndex) *big.Int {
+	return new(big.Int).SetUint64(uint64(memberIndex))
+}
+
+// MemberIndexFromBigInt converts the big integer to a member index. Member
+// indexes start at 1, so an error is returned if the value is not in range
+// [1, MaxMemberIndex].
+func MemberIndexFromBigInt(value *big.Int) (MemberIndex, error) {
+	if value == nil {
+		return 0, fmt.Errorf("member index is nil")
+	}
+	if value.Sign() <= 0 || value.Cmp(big.NewInt(MaxMemberIndex)) > 0 {
+		return 0, fmt.Errorf(
+			"member index [%v] must be in range [1, %v]",
+			value,
+			MaxMemberIndex,
+		)
+	}
+
+	return MemberIndex(value.Uint64()), nil
+}
+
+// SortMemberIndexes sorts the given member indexes in place in ascending
+// order.
+func SortMemberIndexes(memberIndexes []MemberIndex) {
+	sort.Slice(memberIndexes, func(i, j int) bool {
+		return memberIndexes[i] < memberIndexes[j]
+	})
+}
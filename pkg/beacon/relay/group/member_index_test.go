@@ -0,0 +1,88 @@
+package group
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestMemberIndexBigIntRoundTrip(t *testing.T) {
+	for _, memberIndex := range []MemberIndex{1, 2, 128, MaxMemberIndex} {
+		value := MemberIndexToBigInt(memberIndex)
+		if value.Cmp(big.NewInt(int64(memberIndex))) != 0 {
+			t.Errorf(
+				"unexpected big integer\nexpected: %v\nactual:   %v",
+				memberIndex,
+				value,
+			)
+		}
+
+		unmarshalled, err := MemberIndexFromBigInt(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if unmarshalled != memberIndex {
+			t.Errorf(
+				"unexpected member index\nexpected: %v\nactual:   %v",
+				memberIndex,
+				unmarshalled,
+			)
+		}
+	}
+}
+
+func TestMemberIndexFromBigIntOutOfRange(t *testing.T) {
+	var tests = map[string]*big.Int{
+		"nil":                     nil,
+		"zero":                    big.NewInt(0),
+		"negative":                big.NewInt(-1),
+		"greater than max index":  big.NewInt(MaxMemberIndex + 1),
+		"overflowing uint64 type": new(big.Int).Lsh(big.NewInt(1), 64),
+	}
+
+	for testName, value := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if _, err := MemberIndexFromBigInt(value); err == nil {
+				t.Errorf("expected error for member index [%v]", value)
+			}
+		})
+	}
+}
+
+func TestGroupLookupWithConvertedMemberIndex(t *testing.T) {
+	group := NewDkgGroup(2, 5)
+	group.MarkMemberAsDisqualified(4)
+
+	for _, memberID := range group.MemberIDs() {
+		memberIndex, err := MemberIndexFromBigInt(MemberIndexToBigInt(memberID))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expectedOperating := memberID != 4
+		if group.IsOperating(memberIndex) != expectedOperating {
+			t.Errorf(
+				"unexpected operating state of member [%v]\n"+
+					"expected: %v\nactual:   %v",
+				memberIndex,
+				expectedOperating,
+				group.IsOperating(memberIndex),
+			)
+		}
+	}
+}
+
+func TestSortMemberIndexes(t *testing.T) {
+	memberIndexes := []MemberIndex{5, 1, MaxMemberIndex, 3, 1}
+
+	SortMemberIndexes(memberIndexes)
+
+	expected := []MemberIndex{1, 1, 3, 5, MaxMemberIndex}
+	if !reflect.DeepEqual(expected, memberIndexes) {
+		t.Fatalf(
+			"unexpected member indexes\nexpected: %v\nactual:   %v",
+			expected,
+			memberIndexes,
+		)
+	}
+}
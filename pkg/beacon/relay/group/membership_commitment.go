@@ -0,0 +1,128 @@
+package group
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+const (
+	// Domain separation prefixes ensuring a leaf hash can never be confused
+	// with an inner node hash.
+	merkleLeafPrefix = byte(0x00)
+	merkleNodePrefix = byte(0x01)
+)
+
+// MembershipRoot returns the root of a Merkle tree built over sorted IDs of
+// all group members, as initially selected to the group. The root allows
+// verifying membership of a single member with MembershipProof without
+// knowing the full member list.
+func (g *Group) MembershipRoot() []byte {
+	levels := g.membershipTree()
+	if len(levels) == 0 {
+		return nil
+	}
+
+	return levels[len(levels)-1][0]
+}
+
+// MembershipProof returns an inclusion proof for member with the given ID.
+// The proof is a list of sibling hashes from the leaf level up to the root and
+// can be checked against the group's MembershipRoot with VerifyMembership.
+func (g *Group) MembershipProof(memberID MemberIndex) ([][]byte, error) {
+	if !g.isInGroup(memberID) {
+		return nil, fmt.Errorf("member [%v] is not a part of the group", memberID)
+	}
+
+	levels := g.membershipTree()
+
+	index := 0
+	for i, leaf := range levels[0] {
+		if bytes.Equal(leaf, membershipLeaf(memberID)) {
+			index = i
+			break
+		}
+	}
+
+	proof := make([][]byte, 0)
+	for _, level := range levels[:len(levels)-1] {
+		sibling := index ^ 1
+		// The last node on a level with odd number of nodes has no sibling
+		// and is promoted to the next level as-is.
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyMembership checks if the provided proof proves the member with the
+// given ID is a part of the group with the given membership root.
+func VerifyMembership(root []byte, memberID MemberIndex, proof [][]byte) bool {
+	if len(root) == 0 {
+		return false
+	}
+
+	hash := membershipLeaf(memberID)
+	for _, sibling := range proof {
+		hash = membershipNode(hash, sibling)
+	}
+
+	return bytes.Equal(hash, root)
+}
+
+// membershipTree builds all levels of the membership Merkle tree, starting
+// from the leaves and ending with a single-element level holding the root.
+func (g *Group) membershipTree() [][][]byte {
+	if len(g.memberIDs) == 0 {
+		return nil
+	}
+
+	memberIDs := make([]MemberIndex, len(g.memberIDs))
+	copy(memberIDs, g.memberIDs)
+	SortMemberIndexes(memberIDs)
+
+	leaves := make([][]byte, len(memberIDs))
+	for i, memberID := range memberIDs {
+		leaves[i] = membershipLeaf(memberID)
+	}
+
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, membershipNode(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return levels
+}
+
+func membershipLeaf(memberID MemberIndex) []byte {
+	hash := sha256.Sum256([]byte{merkleLeafPrefix, memberID})
+	return hash[:]
+}
+
+// membershipNode hashes two child nodes in lexicographical order so that the
+// proof does not need to carry the position of each sibling.
+func membershipNode(left, right []byte) []byte {
+	if bytes.Compare(left, right) > 0 {
+		left, right = right, left
+	}
+
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, merkleNodePrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
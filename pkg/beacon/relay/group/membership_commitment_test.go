@@ -0,0 +1,132 @@
+package group
+
+import (
+	"testing"
+)
+
+func TestMembershipProof(t *testing.T) {
+	var tests = map[string]struct {
+		groupSize int
+	}{
+		"single member group": {
+			groupSize: 1,
+		},
+		"even number of members": {
+			groupSize: 4,
+		},
+		"odd number of members": {
+			groupSize: 7,
+		},
+		"full group": {
+			groupSize: 255,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			group := NewDkgGroup(test.groupSize/2, test.groupSize)
+			root := group.MembershipRoot()
+
+			for _, memberID := range group.MemberIDs() {
+				proof, err := group.MembershipProof(memberID)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !VerifyMembership(root, memberID, proof) {
+					t.Errorf(
+						"membership proof of member [%v] not verified",
+						memberID,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestMembershipRootIndependentOfMemberOrder(t *testing.T) {
+	group1 := &Group{memberIDs: []MemberIndex{1, 2, 3, 4, 5}}
+	group2 := &Group{memberIDs: []MemberIndex{4, 2, 5, 1, 3}}
+
+	root1 := group1.MembershipRoot()
+	root2 := group2.MembershipRoot()
+
+	if string(root1) != string(root2) {
+		t.Errorf(
+			"unexpected membership root\nexpected: %x\nactual:   %x\n",
+			root1,
+			root2,
+		)
+	}
+}
+
+func TestMembershipProofNotMember(t *testing.T) {
+	group := NewDkgGroup(2, 5)
+
+	_, err := group.MembershipProof(6)
+
+	expectedError := "member [6] is not a part of the group"
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
+
+func TestVerifyMembershipRejectsForgedProof(t *testing.T) {
+	group := NewDkgGroup(2, 5)
+	root := group.MembershipRoot()
+
+	proof, err := group.MembershipProof(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		memberID MemberIndex
+		proof    func() [][]byte
+		root     []byte
+	}{
+		"proof of other member": {
+			memberID: 6,
+			proof:    func() [][]byte { return proof },
+			root:     root,
+		},
+		"modified proof element": {
+			memberID: 3,
+			proof: func() [][]byte {
+				forged := make([][]byte, len(proof))
+				copy(forged, proof)
+				forged[0] = append([]byte{}, proof[0]...)
+				forged[0][0] ^= 0x01
+				return forged
+			},
+			root: root,
+		},
+		"truncated proof": {
+			memberID: 3,
+			proof:    func() [][]byte { return proof[:len(proof)-1] },
+			root:     root,
+		},
+		"leaf as a root": {
+			memberID: 3,
+			proof:    func() [][]byte { return [][]byte{} },
+			root:     membershipLeaf(4),
+		},
+		"root of other group": {
+			memberID: 3,
+			proof:    func() [][]byte { return proof },
+			root:     NewDkgGroup(3, 6).MembershipRoot(),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if VerifyMembership(test.root, test.memberID, test.proof()) {
+				t.Errorf("forged membership proof verified")
+			}
+		})
+	}
+}
@@ -38,7 +38,8 @@ type Result struct {
 }
 
 // CandidateToNewGroup attempts to generate and submit tickets for the
-// staker to join a new group.
+// staker to join a new group. Staker with stake below the minimum stake does
+// not generate any tickets and does not take part in the group selection.
 //
 // To minimize the submitter's cost by minimizing the number of redundant
 // tickets that are not selected into the group, tickets are submitted in
@@ -78,6 +79,20 @@ func CandidateToNewGroup(
 		return err
 	}
 
+	// Staker below the minimum stake has no virtual stakers and all tickets
+	// it could submit would be rejected on-chain. There is no point in
+	// taking part in the ticket submission.
+	if availableStake.Cmp(minimumStake) < 0 {
+		logger.Infof(
+			"staker [0x%x] with stake [%v] is below the minimum stake [%v]; "+
+				"skipping ticket submission",
+			staker.Address(),
+			availableStake,
+			minimumStake,
+		)
+		return nil
+	}
+
 	tickets, err := generateTickets(
 		newEntry.Bytes(),
 		staker.Address(),
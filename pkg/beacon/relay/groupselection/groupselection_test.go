@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
@@ -315,6 +316,113 @@ func TestRoundCandidateTickets(t *testing.T) {
 	}
 }
 
+func TestCandidateToNewGroupMinimumStake(t *testing.T) {
+	minimumStake := big.NewInt(20)
+
+	var tests = map[string]struct {
+		availableStake             *big.Int
+		expectedTicketsCount       int
+		expectedGroupSelectionDone bool
+	}{
+		"stake above minimum stake": {
+			availableStake:             big.NewInt(60),
+			expectedTicketsCount:       3,
+			expectedGroupSelectionDone: true,
+		},
+		"stake below minimum stake": {
+			availableStake:             big.NewInt(19),
+			expectedTicketsCount:       0,
+			expectedGroupSelectionDone: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainConfig := &chain.Config{
+				GroupSize:               5,
+				TicketSubmissionTimeout: 24,
+			}
+
+			localChain := local.Connect(
+				chainConfig.GroupSize,
+				3, // honest threshold
+				minimumStake,
+			)
+			relayChain := localChain.ThresholdRelay()
+
+			blockCounter, err := localChain.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			startBlockHeight, err := blockCounter.CurrentBlock()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			staker := &stubStaker{
+				address: stakingAddress,
+				stake:   test.availableStake,
+			}
+
+			groupSelectionDone := make(chan *Result, 1)
+
+			err = CandidateToNewGroup(
+				relayChain,
+				blockCounter,
+				chainConfig,
+				staker,
+				new(big.Int).SetBytes(previousBeaconOutput),
+				startBlockHeight,
+				func(result *Result) {
+					groupSelectionDone <- result
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			submittedTickets, err := relayChain.GetSubmittedTickets()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(submittedTickets) != test.expectedTicketsCount {
+				t.Errorf(
+					"unexpected number of submitted tickets\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedTicketsCount,
+					len(submittedTickets),
+				)
+			}
+
+			select {
+			case <-groupSelectionDone:
+				if !test.expectedGroupSelectionDone {
+					t.Errorf("unexpected group selection result")
+				}
+			case <-time.After(100 * time.Millisecond):
+				if test.expectedGroupSelectionDone {
+					t.Errorf("expected group selection result")
+				}
+			}
+		})
+	}
+}
+
+type stubStaker struct {
+	address chain.StakerAddress
+	stake   *big.Int
+}
+
+func (ss *stubStaker) Address() chain.StakerAddress {
+	return ss.address
+}
+
+func (ss *stubStaker) Stake() (*big.Int, error) {
+	return ss.stake, nil
+}
+
 type stubGroupInterface struct {
 	groupSize        int
 	submittedTickets []*chain.Ticket
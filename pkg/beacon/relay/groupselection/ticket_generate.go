@@ -1,6 +1,7 @@
 package groupselection
 
 import (
+	"fmt"
 	"math/big"
 	"sort"
 )
@@ -8,6 +9,11 @@ import (
 // generateTickets generates a set of tickets for the given staker and relay
 // entry value given the specified stake parameters and natural threshold.
 //
+// The number of generated tickets is equal to the staking weight of the staker,
+// that is the available stake divided by the minimum stake. Each ticket is
+// generated for a separate virtual staker and its value is calculated
+// independently of other tickets.
+//
 // Tickets are returned sorted in ascending order by their value.
 func generateTickets(
 	beaconValue []byte, // V_i
@@ -15,7 +21,20 @@ func generateTickets(
 	availableStake *big.Int, // S_j
 	minimumStake *big.Int,
 ) ([]*ticket, error) {
+	if minimumStake.Sign() <= 0 {
+		return nil, fmt.Errorf(
+			"minimum stake must be positive; has [%v]",
+			minimumStake,
+		)
+	}
+
 	stakingWeight := new(big.Int).Quo(availableStake, minimumStake) // W_j
+	if !stakingWeight.IsInt64() {
+		return nil, fmt.Errorf(
+			"staking weight [%v] is out of the supported range",
+			stakingWeight,
+		)
+	}
 
 	tickets := make([]*ticket, 0)
 	for virtualStaker := int64(1); virtualStaker <= stakingWeight.Int64(); virtualStaker++ {
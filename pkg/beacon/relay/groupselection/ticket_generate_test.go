@@ -58,3 +58,142 @@ func TestTicketsGeneratedInOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestTicketsCountProportionalToStake(t *testing.T) {
+	minimumStake := big.NewInt(20)
+
+	var tests = map[string]struct {
+		availableStake       *big.Int
+		expectedTicketsCount int
+	}{
+		"stake below minimum stake": {
+			availableStake:       big.NewInt(19),
+			expectedTicketsCount: 0,
+		},
+		"minimum stake": {
+			availableStake:       big.NewInt(20),
+			expectedTicketsCount: 1,
+		},
+		"3x minimum stake": {
+			availableStake:       big.NewInt(60),
+			expectedTicketsCount: 3,
+		},
+		"3x minimum stake with a remainder": {
+			availableStake:       big.NewInt(79),
+			expectedTicketsCount: 3,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			tickets, err := generateTickets(
+				previousBeaconOutput,
+				stakingAddress,
+				test.availableStake,
+				minimumStake,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(tickets) != test.expectedTicketsCount {
+				t.Fatalf(
+					"unexpected number of tickets\nexpected: %v\nactual:   %v\n",
+					test.expectedTicketsCount,
+					len(tickets),
+				)
+			}
+
+			// Each virtual staker should receive exactly one ticket.
+			virtualStakers := make(map[int64]bool)
+			for _, ticket := range tickets {
+				virtualStakers[ticket.proof.virtualStakerIndex.Int64()] = true
+			}
+			for i := int64(1); i <= int64(test.expectedTicketsCount); i++ {
+				if !virtualStakers[i] {
+					t.Errorf("no ticket for virtual staker [%v]", i)
+				}
+			}
+		})
+	}
+}
+
+func TestTicketValuesUniformlyDistributed(t *testing.T) {
+	minimumStake := big.NewInt(1)
+	availableStake := big.NewInt(1600)
+
+	tickets, err := generateTickets(
+		previousBeaconOutput,
+		stakingAddress,
+		availableStake,
+		minimumStake,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Split the ticket value space into buckets by the 4 most significant
+	// bits and run a chi-squared test against the uniform distribution.
+	buckets := 16
+	observed := make([]int, buckets)
+	for _, ticket := range tickets {
+		observed[ticket.value[0]>>4]++
+	}
+
+	expected := float64(len(tickets)) / float64(buckets)
+	chiSquared := 0.0
+	for _, count := range observed {
+		difference := float64(count) - expected
+		chiSquared += difference * difference / expected
+	}
+
+	// Critical value of the chi-squared distribution for 15 degrees of
+	// freedom and significance level 0.001.
+	criticalValue := 37.697
+	if chiSquared > criticalValue {
+		t.Errorf(
+			"ticket values not uniformly distributed; "+
+				"chi-squared [%v] exceeds critical value [%v]; buckets: %v",
+			chiSquared,
+			criticalValue,
+			observed,
+		)
+	}
+
+	// Ticket order should not follow the order of virtual stakers. Tickets
+	// of the first half of virtual stakers should be spread evenly between
+	// the lower and upper half of sorted tickets.
+	firstHalfInLowerHalf := 0
+	for _, ticket := range tickets[:len(tickets)/2] {
+		if ticket.proof.virtualStakerIndex.Int64() <= int64(len(tickets)/2) {
+			firstHalfInLowerHalf++
+		}
+	}
+	if firstHalfInLowerHalf < len(tickets)/5 || firstHalfInLowerHalf > len(tickets)*3/10 {
+		t.Errorf(
+			"ticket values correlated with virtual staker index; "+
+				"[%v] of [%v] lowest tickets belong to the first half "+
+				"of virtual stakers",
+			firstHalfInLowerHalf,
+			len(tickets)/2,
+		)
+	}
+}
+
+func TestGenerateTicketsInvalidMinimumStake(t *testing.T) {
+	_, err := generateTickets(
+		previousBeaconOutput,
+		stakingAddress,
+		big.NewInt(100),
+		big.NewInt(0),
+	)
+
+	expectedError := "minimum stake must be positive; has [0]"
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
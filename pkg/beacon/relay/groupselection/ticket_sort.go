@@ -1,6 +1,10 @@
 package groupselection
 
-// byValue implements sort.Interface sorting tickets by their value.
+import "bytes"
+
+// byValue implements sort.Interface sorting tickets by their value. Tickets
+// with equal values are ordered by the staker value and then by the virtual
+// staker index so that all nodes order the same set of tickets identically.
 type byValue []*ticket
 
 // Len is the sort.Interface requirement for ticket ordering.
@@ -15,5 +19,19 @@ func (bv byValue) Swap(i, j int) {
 
 // Less is the sort.Interface requirement for ticket ordering.
 func (bv byValue) Less(i, j int) bool {
-	return bv[i].intValue().Cmp(bv[j].intValue()) < 1
+	if valueOrder := bv[i].intValue().Cmp(bv[j].intValue()); valueOrder != 0 {
+		return valueOrder < 0
+	}
+
+	stakerValueOrder := bytes.Compare(
+		bv[i].proof.stakerValue,
+		bv[j].proof.stakerValue,
+	)
+	if stakerValueOrder != 0 {
+		return stakerValueOrder < 0
+	}
+
+	return bv[i].proof.virtualStakerIndex.Cmp(
+		bv[j].proof.virtualStakerIndex,
+	) < 0
 }
@@ -32,6 +32,47 @@ func TestSortByValue(t *testing.T) {
 	assertTicketAtIndex(t, tickets, 4, ticket5)
 }
 
+func TestSortByValueWithCollidingValues(t *testing.T) {
+	newCollidingTicket := func(stakerValue byte, virtualStakerIndex uint32) *ticket {
+		ticket := newTestTicket(virtualStakerIndex, 1000)
+		ticket.proof.stakerValue = []byte{stakerValue}
+		return ticket
+	}
+
+	ticket1 := newCollidingTicket(1, 1)
+	ticket2 := newCollidingTicket(1, 2)
+	ticket3 := newCollidingTicket(2, 1)
+	ticket4 := newCollidingTicket(2, 3)
+	ticket5 := newTestTicket(1, 1001)
+	ticket5.proof.stakerValue = []byte{0}
+
+	var tests = map[string]struct {
+		tickets []*ticket
+	}{
+		"ascending order": {
+			tickets: []*ticket{ticket1, ticket2, ticket3, ticket4, ticket5},
+		},
+		"descending order": {
+			tickets: []*ticket{ticket5, ticket4, ticket3, ticket2, ticket1},
+		},
+		"mixed order": {
+			tickets: []*ticket{ticket3, ticket5, ticket1, ticket4, ticket2},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			sort.Stable(byValue(test.tickets))
+
+			assertTicketAtIndex(t, test.tickets, 0, ticket1)
+			assertTicketAtIndex(t, test.tickets, 1, ticket2)
+			assertTicketAtIndex(t, test.tickets, 2, ticket3)
+			assertTicketAtIndex(t, test.tickets, 3, ticket4)
+			assertTicketAtIndex(t, test.tickets, 4, ticket5)
+		})
+	}
+}
+
 func assertTicketAtIndex(t *testing.T, tickets []*ticket, index int, ticket *ticket) {
 	if !reflect.DeepEqual(ticket, tickets[index]) {
 		t.Errorf(
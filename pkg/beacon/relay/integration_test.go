@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/entry"
 
@@ -113,6 +114,135 @@ func TestLessThanHonestThresholdMembersSigning(t *testing.T) {
 	dkgtest.AssertSamePublicKey(t, dkgResult)
 	entrytest.AssertEntryNotPublished(t, signingResult)
 	entrytest.AssertSignerFailuresCount(t, signingResult, signingMembersCount)
+	entrytest.AssertSignerFailuresClassified(
+		t,
+		signingResult,
+		entry.FailureTimeout,
+		signingMembersCount,
+	)
+}
+
+// Success: honest threshold of the signing group members participate in
+// signing but the signature share of the last member arrives late, long after
+// all the other shares have been collected. Shares collected so far are kept
+// and the signature is completed once the late share arrives, still before
+// the relay entry timeout.
+func TestLateSignatureShareSigning(t *testing.T) {
+	t.Parallel()
+
+	noopInterceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		return msg
+	}
+
+	dkgSeed := dkgtest.RandomSeed(t)
+	dkgResult, err := dkgtest.RunTest(
+		groupSize,
+		honestThreshold,
+		dkgSeed,
+		noopInterceptor,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lateMemberID := group.MemberIndex(honestThreshold)
+	lateShareDelay := 5 * time.Second
+
+	signingInterceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		shareMessage, ok := msg.(*entry.SignatureShareMessage)
+		if ok && shareMessage.SenderID() == lateMemberID {
+			time.Sleep(lateShareDelay)
+		}
+		return msg
+	}
+
+	signingResult, err := entrytest.RunTest(
+		dkgResult.GetSigners()[0:honestThreshold],
+		honestThreshold,
+		signingInterceptor,
+		previousEntry(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dkgtest.AssertDkgResultPublished(t, dkgResult)
+	dkgtest.AssertSamePublicKey(t, dkgResult)
+	entrytest.AssertEntryPublished(t, signingResult)
+	entrytest.AssertNoSignerFailures(t, signingResult)
+
+	groupPublicKey, err := getFirstGroupPublicKey(dkgResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newEntry, err := signingResult.EntryValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bls.VerifyG1(groupPublicKey, previousEntryG1(), newEntry) {
+		t.Errorf("threshold signature failed BLS verification")
+	}
+}
+
+// Success: members slashed on-chain are excluded from signing and the
+// remaining active members still meet the honest threshold.
+func TestSlashedMembersExcludedFromSigning(t *testing.T) {
+	t.Parallel()
+
+	slashedMembers := []group.MemberIndex{2, 5, 7, 9}
+	dkgResult, signingResult := runTestWithSlashedMembers(
+		t,
+		groupSize,
+		honestThreshold,
+		slashedMembers,
+	)
+
+	dkgtest.AssertDkgResultPublished(t, dkgResult)
+	dkgtest.AssertSamePublicKey(t, dkgResult)
+	entrytest.AssertEntryPublished(t, signingResult)
+	entrytest.AssertSignerFailuresCount(t, signingResult, len(slashedMembers))
+
+	groupPublicKey, err := getFirstGroupPublicKey(dkgResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newEntry, err := signingResult.EntryValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bls.VerifyG1(groupPublicKey, previousEntryG1(), newEntry) {
+		t.Errorf("threshold signature failed BLS verification")
+	}
+}
+
+// Failure: members slashed on-chain are excluded from signing and the
+// remaining active members do not meet the honest threshold, even though
+// all group members are online.
+func TestSlashedMembersBelowHonestThresholdSigning(t *testing.T) {
+	t.Parallel()
+
+	slashedMembers := []group.MemberIndex{2, 5, 7, 9, 10}
+	dkgResult, signingResult := runTestWithSlashedMembers(
+		t,
+		groupSize,
+		honestThreshold,
+		slashedMembers,
+	)
+
+	dkgtest.AssertDkgResultPublished(t, dkgResult)
+	dkgtest.AssertSamePublicKey(t, dkgResult)
+	entrytest.AssertEntryNotPublished(t, signingResult)
+	entrytest.AssertSignerFailuresCount(t, signingResult, groupSize)
+	entrytest.AssertSignerFailuresClassified(
+		t,
+		signingResult,
+		entry.FailureInsufficientShares,
+		groupSize-len(slashedMembers),
+	)
 }
 
 // Success: honest threshold of the signing group members participate in
@@ -282,6 +412,45 @@ func TestSigningWithInvalidSignatureShare(t *testing.T) {
 	}
 }
 
+// Failure: signature produced by the group does not match the public key of
+// the group selected to produce the entry, so no entry is submitted.
+func TestSigningWithMismatchedGroupPublicKey(t *testing.T) {
+	t.Parallel()
+
+	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		return msg
+	}
+
+	dkgSeed := dkgtest.RandomSeed(t)
+	dkgResult, err := dkgtest.RunTest(groupSize, honestThreshold, dkgSeed, interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherGroupPublicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(7)).Marshal()
+
+	signingResult, err := entrytest.RunTestWithGroupPublicKey(
+		dkgResult.GetSigners(),
+		honestThreshold,
+		interceptor,
+		previousEntry(),
+		otherGroupPublicKey,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dkgtest.AssertDkgResultPublished(t, dkgResult)
+	entrytest.AssertEntryNotPublished(t, signingResult)
+	entrytest.AssertSignerFailuresCount(t, signingResult, groupSize)
+	entrytest.AssertSignerFailuresClassified(
+		t,
+		signingResult,
+		entry.FailureVerificationFailed,
+		groupSize,
+	)
+}
+
 func runTest(t *testing.T, groupSize, honestThreshold, honestSignersCount int) (
 	*dkgtest.Result,
 	*entrytest.Result,
@@ -328,6 +497,38 @@ func runTestWithInterceptor(
 	return dkgResult, signingResult
 }
 
+func runTestWithSlashedMembers(
+	t *testing.T,
+	groupSize, honestThreshold int,
+	slashedMembers []group.MemberIndex,
+) (
+	*dkgtest.Result,
+	*entrytest.Result,
+) {
+	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		return msg
+	}
+
+	dkgSeed := dkgtest.RandomSeed(t)
+	dkgResult, err := dkgtest.RunTest(groupSize, honestThreshold, dkgSeed, interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signingResult, err := entrytest.RunTestWithSlashedMembers(
+		dkgResult.GetSigners(),
+		honestThreshold,
+		interceptor,
+		previousEntry(),
+		slashedMembers,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dkgResult, signingResult
+}
+
 func getFirstGroupPublicKey(result *dkgtest.Result) (*bn256.G2, error) {
 	signers := result.GetSigners()
 	if len(signers) == 0 {
@@ -2,19 +2,15 @@ package relay
 
 import (
 	"bytes"
-	"encoding/hex"
-	"fmt"
 	"math/big"
 	"sync"
 
-	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
-	"github.com/keep-network/keep-core/pkg/altbn128"
-
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/entry"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/groupselection"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -35,6 +31,93 @@ type Node struct {
 	chainConfig  *relaychain.Config
 
 	groupRegistry *registry.Groups
+
+	signingLimiter *signingLimiter
+
+	signingFailureHandler func(failure entry.Failure)
+	entryConfirmedHandler func(newEntry []byte)
+
+	hashToSignInput entry.HashToSignInput
+
+	lastSeenEntry []byte
+}
+
+// SetHashToSignInput configures the function converting the previous relay
+// entry into the input signed by the group. Passing nil restores
+// entry.DefaultHashToSignInput.
+func (n *Node) SetHashToSignInput(hashToSignInput entry.HashToSignInput) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.hashToSignInput = hashToSignInput
+}
+
+// signInput returns the input signed by the group for the given previous
+// relay entry.
+func (n *Node) signInput(previousEntry []byte) []byte {
+	n.mutex.Lock()
+	hashToSignInput := n.hashToSignInput
+	n.mutex.Unlock()
+
+	if hashToSignInput == nil {
+		hashToSignInput = entry.DefaultHashToSignInput
+	}
+
+	return hashToSignInput(previousEntry)
+}
+
+// OnSigningFailure registers a handler called with the category of each
+// relay entry signing failure of this node. It lets to record failures in
+// metrics distinctly for each category.
+func (n *Node) OnSigningFailure(handler func(failure entry.Failure)) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.signingFailureHandler = handler
+}
+
+func (n *Node) notifySigningFailure(failure entry.Failure) {
+	n.mutex.Lock()
+	handler := n.signingFailureHandler
+	n.mutex.Unlock()
+
+	if handler != nil {
+		handler(failure)
+	}
+}
+
+// OnRelayEntryConfirmed registers a handler called with each relay entry
+// submitted by this node once the entry is buried under the relay entry
+// confirmation depth blocks. Entries submitted by other members are not
+// reported.
+func (n *Node) OnRelayEntryConfirmed(handler func(newEntry []byte)) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.entryConfirmedHandler = handler
+}
+
+func (n *Node) notifyEntryConfirmed(newEntry []byte) {
+	n.mutex.Lock()
+	handler := n.entryConfirmedHandler
+	n.mutex.Unlock()
+
+	if handler != nil {
+		handler(newEntry)
+	}
+}
+
+// RemoveMembership drops the membership of this node's member with the given
+// index in the group with the given public key, for example when the group
+// key has been retired or the member has been slashed. Secret share of the
+// member is wiped and relay requests for the group no longer consider the
+// membership. Once the last membership of the group is dropped, the node is
+// no longer in the group.
+func (n *Node) RemoveMembership(
+	groupPublicKey []byte,
+	memberIndex group.MemberIndex,
+) error {
+	return n.groupRegistry.RemoveMembership(groupPublicKey, memberIndex)
 }
 
 // IsInGroup checks if this node is a member of the group which was selected to
@@ -43,6 +126,24 @@ func (n *Node) IsInGroup(groupPublicKey []byte) bool {
 	return len(n.groupRegistry.GetGroup(groupPublicKey)) > 0
 }
 
+// GroupPublicKeys returns public keys of all groups this node is a member of.
+func (n *Node) GroupPublicKeys() [][]byte {
+	return n.groupRegistry.GroupPublicKeys()
+}
+
+// GroupCount returns the number of groups this node is a member of.
+func (n *Node) GroupCount() int {
+	return n.groupRegistry.GroupCount()
+}
+
+// NeedsGenesis returns true if the node is not a member of any group yet.
+// Relay requests are not handled by such a node, so the orchestration layer
+// should make it participate in the genesis key generation instead to
+// bootstrap the first group.
+func (n *Node) NeedsGenesis() bool {
+	return n.GroupCount() == 0
+}
+
 // JoinGroupIfEligible takes a threshold relay entry value and undergoes the
 // process of joining a group if this node's virtual stakers prove eligible for
 // the group generated by that entry. This is an interactive on-chain process,
@@ -75,9 +176,7 @@ func (n *Node) JoinGroupIfEligible(
 		}
 	}
 
-	// create temporary broadcast channel name for DKG using the
-	// group selection seed
-	channelName := newEntry.Text(16)
+	channelName := dkgChannelName(newEntry)
 
 	if len(indexes) > 0 {
 		broadcastChannel, err := n.netProvider.BroadcastChannelFor(channelName)
@@ -110,11 +209,13 @@ func (n *Node) JoinGroupIfEligible(
 					playerIndex,
 					n.chainConfig.GroupSize,
 					n.chainConfig.DishonestThreshold(),
+					n.chainConfig.MaxAccusations,
 					membershipValidator,
 					dkgStartBlockHeight,
 					n.blockCounter,
 					relayChain,
 					signing,
+					n.Staker,
 					broadcastChannel,
 				)
 				if err != nil {
@@ -122,13 +223,10 @@ func (n *Node) JoinGroupIfEligible(
 					return
 				}
 
-				// final broadcast channel name for group is the compressed
-				// public key of the group
-				channelName := hex.EncodeToString(
-					signer.GroupPublicKeyBytesCompressed(),
+				err = n.groupRegistry.RegisterGroup(
+					signer,
+					groupChannelName(signer.GroupPublicKeyBytesCompressed()),
 				)
-
-				err = n.groupRegistry.RegisterGroup(signer, channelName)
 				if err != nil {
 					logger.Errorf("failed to register a group: [%v]", err)
 				}
@@ -148,7 +246,7 @@ func (n *Node) JoinGroupIfEligible(
 // messages to other nodes even if this node is not a part of the group which
 // signs the relay entry.
 func (n *Node) ForwardSignatureShares(groupPublicKeyBytes []byte) {
-	name, err := channelNameForPublicKeyBytes(groupPublicKeyBytes)
+	name, err := groupChannelNameForPublicKey(groupPublicKeyBytes)
 	if err != nil {
 		logger.Warningf("could not forward signature shares: [%v]", err)
 		return
@@ -211,26 +309,3 @@ func (n *Node) ResumeSigningIfEligible(
 		)
 	}
 }
-
-// channelNameForPublicKey takes group public key represented by marshalled
-// G2 point and transforms it into a broadcast channel name.
-// Broadcast channel name for group is the hexadecimal representation of
-// compressed public key of the group.
-func channelNameForPublicKeyBytes(groupPublicKey []byte) (string, error) {
-	g2 := new(bn256.G2)
-
-	if _, err := g2.Unmarshal(groupPublicKey); err != nil {
-		return "", fmt.Errorf("could not create channel name: [%v]", err)
-	}
-
-	return channelNameForPublicKey(g2), nil
-}
-
-// channelNameForPublicKey takes group public key represented by G2 point
-// and transforms it into a broadcast channel name.
-// Broadcast channel name for group is the hexadecimal representation of
-// compressed public key of the group.
-func channelNameForPublicKey(groupPublicKey *bn256.G2) string {
-	altbn128GroupPublicKey := altbn128.G2Point{G2: groupPublicKey}
-	return hex.EncodeToString(altbn128GroupPublicKey.Compress())
-}
@@ -31,6 +31,7 @@ type ThresholdSigner struct {
 	GroupPublicKey       []byte            `protobuf:"bytes,2,opt,name=groupPublicKey,proto3" json:"groupPublicKey,omitempty"`
 	GroupPrivateKeyShare string            `protobuf:"bytes,3,opt,name=groupPrivateKeyShare,proto3" json:"groupPrivateKeyShare,omitempty"`
 	GroupPublicKeyShares map[uint32][]byte `protobuf:"bytes,4,rep,name=groupPublicKeyShares,proto3" json:"groupPublicKeyShares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HonestThreshold      uint32            `protobuf:"varint,5,opt,name=honestThreshold,proto3" json:"honestThreshold,omitempty"`
 }
 
 func (m *ThresholdSigner) Reset()      { *m = ThresholdSigner{} }
@@ -93,6 +94,13 @@ func (m *ThresholdSigner) GetGroupPublicKeyShares() map[uint32][]byte {
 	return nil
 }
 
+func (m *ThresholdSigner) GetHonestThreshold() uint32 {
+	if m != nil {
+		return m.HonestThreshold
+	}
+	return 0
+}
+
 type Membership struct {
 	Signer  []byte `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
@@ -153,28 +161,28 @@ func init() {
 func init() { proto.RegisterFile("pb/message.proto", fileDescriptor_8447775385e7eb85) }
 
 var fileDescriptor_8447775385e7eb85 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xc3, 0x40,
-	0x1c, 0xc5, 0x73, 0xa9, 0x56, 0x7b, 0xad, 0x5a, 0x8e, 0x22, 0xd1, 0xe1, 0x4f, 0xe8, 0x20, 0x99,
-	0x22, 0xb4, 0x4b, 0x71, 0x70, 0x10, 0xa4, 0x48, 0x11, 0x24, 0x75, 0x72, 0x4b, 0xda, 0x3f, 0x49,
-	0x30, 0x4d, 0xc2, 0x5d, 0x5a, 0xcc, 0xe6, 0x47, 0xf0, 0x63, 0xf8, 0x35, 0xdc, 0x1c, 0x3b, 0x76,
-	0xb4, 0xd7, 0xc5, 0xb1, 0x1f, 0x41, 0xbc, 0x36, 0x20, 0x25, 0x6e, 0xf7, 0xde, 0xf1, 0x7b, 0xbc,
-	0x77, 0x47, 0x9b, 0xa9, 0x77, 0x39, 0x41, 0x21, 0x5c, 0x1f, 0xed, 0x94, 0x27, 0x59, 0xc2, 0x0e,
-	0x39, 0xfa, 0xa1, 0xc8, 0x78, 0xde, 0xfe, 0xd0, 0xe9, 0xc9, 0x63, 0xc0, 0x51, 0x04, 0x49, 0x34,
-	0x1e, 0x86, 0x7e, 0x8c, 0x9c, 0x99, 0xb4, 0x3e, 0xc1, 0x89, 0x87, 0xfc, 0x2e, 0x1e, 0xe3, 0x8b,
-	0x41, 0x4c, 0x62, 0x1d, 0x39, 0x7f, 0x2d, 0x76, 0x41, 0x8f, 0x7d, 0x9e, 0x4c, 0xd3, 0x87, 0xa9,
-	0x17, 0x85, 0xa3, 0x01, 0xe6, 0x86, 0x6e, 0x12, 0xab, 0xe1, 0xec, 0xb8, 0xac, 0x43, 0x5b, 0x1b,
-	0x87, 0x87, 0x33, 0x37, 0xc3, 0x01, 0xe6, 0xc3, 0xc0, 0xe5, 0x68, 0x54, 0x4c, 0x62, 0xd5, 0x9c,
-	0xd2, 0x3b, 0xe6, 0x17, 0x4c, 0x91, 0xa2, 0x6c, 0x61, 0xec, 0x99, 0x15, 0xab, 0xde, 0xe9, 0xda,
-	0x45, 0x75, 0x7b, 0xa7, 0xb6, 0xdd, 0x2f, 0xa1, 0x6e, 0xe3, 0x8c, 0xe7, 0x4e, 0x69, 0xe0, 0x79,
-	0x9f, 0x9e, 0xfd, 0x8b, 0xb0, 0x26, 0xad, 0x3c, 0x63, 0xbe, 0xdd, 0xfe, 0x7b, 0x64, 0x2d, 0xba,
-	0x3f, 0x73, 0xa3, 0x29, 0x6e, 0xa7, 0x6e, 0xc4, 0x95, 0xde, 0x23, 0xed, 0x6b, 0x4a, 0xef, 0xd5,
-	0xe3, 0x88, 0x20, 0x4c, 0xd9, 0x29, 0xad, 0x0a, 0x55, 0x48, 0xc1, 0x0d, 0x67, 0xab, 0x98, 0x41,
-	0x0f, 0x46, 0x81, 0x1b, 0xc7, 0x18, 0xa9, 0x84, 0x9a, 0x53, 0xc8, 0x9b, 0xde, 0x7c, 0x09, 0xda,
-	0x62, 0x09, 0xda, 0x7a, 0x09, 0xe4, 0x55, 0x02, 0x79, 0x97, 0x40, 0x3e, 0x25, 0x90, 0xb9, 0x04,
-	0xf2, 0x25, 0x81, 0x7c, 0x4b, 0xd0, 0xd6, 0x12, 0xc8, 0xdb, 0x0a, 0xb4, 0xf9, 0x0a, 0xb4, 0xc5,
-	0x0a, 0xb4, 0x27, 0x3d, 0xf5, 0xbc, 0xaa, 0xfa, 0xce, 0xee, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0xd8, 0x54, 0xe3, 0xe2, 0x01, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x12, 0x28, 0x48, 0xd2, 0xcf,
+	0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x28, 0x4a,
+	0x4d, 0xcf, 0x2c, 0x2e, 0x29, 0xaa, 0x54, 0x7a, 0xcd, 0xc4, 0xc5, 0x1f, 0x92, 0x51, 0x94, 0x5a,
+	0x9c, 0x91, 0x9f, 0x93, 0x12, 0x9c, 0x99, 0x9e, 0x97, 0x5a, 0x24, 0xa4, 0xc0, 0xc5, 0x9d, 0x9b,
+	0x9a, 0x9b, 0x94, 0x5a, 0xe4, 0x99, 0x97, 0x92, 0x5a, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1b,
+	0x84, 0x2c, 0x24, 0xa4, 0xc6, 0xc5, 0x97, 0x5e, 0x94, 0x5f, 0x5a, 0x10, 0x50, 0x9a, 0x94, 0x93,
+	0x99, 0xec, 0x9d, 0x5a, 0x29, 0xc1, 0x04, 0x54, 0xc4, 0x13, 0x84, 0x26, 0x2a, 0x64, 0xc4, 0x25,
+	0x02, 0x11, 0x29, 0xca, 0x2c, 0x4b, 0x2c, 0x49, 0x05, 0x0a, 0x05, 0x67, 0x24, 0x16, 0xa5, 0x4a,
+	0x30, 0x03, 0x55, 0x73, 0x06, 0x61, 0x95, 0x13, 0x4a, 0x87, 0xe9, 0x81, 0x99, 0x02, 0x16, 0x2e,
+	0x96, 0x60, 0x51, 0x60, 0xd6, 0xe0, 0x36, 0x32, 0xd6, 0x83, 0x39, 0x5d, 0x0f, 0xcd, 0xd9, 0x7a,
+	0xee, 0x58, 0x74, 0xb9, 0xe6, 0x01, 0x55, 0x06, 0x61, 0x35, 0x50, 0x48, 0x83, 0x8b, 0x3f, 0x23,
+	0x3f, 0x2f, 0xb5, 0xb8, 0x04, 0x6e, 0x90, 0x04, 0x2b, 0xd8, 0xab, 0xe8, 0xc2, 0x52, 0xee, 0x5c,
+	0x92, 0x38, 0x0d, 0x17, 0x12, 0xe0, 0x62, 0xce, 0x06, 0x06, 0x00, 0x24, 0x94, 0x40, 0x4c, 0x21,
+	0x11, 0x2e, 0xd6, 0xb2, 0xc4, 0x9c, 0xd2, 0x54, 0x68, 0xa0, 0x40, 0x38, 0x56, 0x4c, 0x16, 0x8c,
+	0x4a, 0x76, 0x5c, 0x5c, 0xbe, 0xe0, 0x60, 0x2c, 0xce, 0xc8, 0x2c, 0x10, 0x12, 0xe3, 0x62, 0x2b,
+	0x06, 0x3b, 0x1d, 0xac, 0x99, 0x27, 0x08, 0xca, 0x13, 0x92, 0xe0, 0x62, 0x4f, 0xce, 0x48, 0xcc,
+	0xcb, 0x4b, 0xcd, 0x01, 0x9b, 0xc0, 0x19, 0x04, 0xe3, 0x3a, 0x59, 0x5c, 0x78, 0x28, 0xc7, 0x70,
+	0x03, 0x88, 0x3f, 0x3c, 0x94, 0x63, 0x6c, 0x78, 0x24, 0xc7, 0xb8, 0x02, 0x88, 0x4f, 0x00, 0xf1,
+	0x05, 0x20, 0x7e, 0x00, 0xc4, 0x2f, 0x1e, 0x01, 0xe5, 0x80, 0xf4, 0x84, 0xc7, 0x72, 0x0c, 0x17,
+	0x80, 0xf8, 0x06, 0x10, 0x47, 0x31, 0x15, 0x24, 0x25, 0xb1, 0x81, 0x23, 0xde, 0x18, 0x00, 0x10,
+	0xdb, 0xcf, 0x4a, 0x0c, 0x02, 0x00, 0x00,
 }
 
 func (this *ThresholdSigner) Equal(that interface{}) bool {
@@ -213,6 +221,9 @@ func (this *ThresholdSigner) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HonestThreshold != that1.HonestThreshold {
+		return false
+	}
 	return true
 }
 func (this *Membership) Equal(that interface{}) bool {
@@ -246,7 +257,7 @@ func (this *ThresholdSigner) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.ThresholdSigner{")
 	s = append(s, "MemberIndex: "+fmt.Sprintf("%#v", this.MemberIndex)+",\n")
 	s = append(s, "GroupPublicKey: "+fmt.Sprintf("%#v", this.GroupPublicKey)+",\n")
@@ -264,6 +275,7 @@ func (this *ThresholdSigner) GoString() string {
 	if this.GroupPublicKeyShares != nil {
 		s = append(s, "GroupPublicKeyShares: "+mapStringForGroupPublicKeyShares+",\n")
 	}
+	s = append(s, "HonestThreshold: "+fmt.Sprintf("%#v", this.HonestThreshold)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -306,6 +318,11 @@ func (m *ThresholdSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HonestThreshold != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.HonestThreshold))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.GroupPublicKeyShares) > 0 {
 		for k := range m.GroupPublicKeyShares {
 			v := m.GroupPublicKeyShares[k]
@@ -424,6 +441,9 @@ func (m *ThresholdSigner) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	if m.HonestThreshold != 0 {
+		n += 1 + sovMessage(uint64(m.HonestThreshold))
+	}
 	return n
 }
 
@@ -469,6 +489,7 @@ func (this *ThresholdSigner) String() string {
 		`GroupPublicKey:` + fmt.Sprintf("%v", this.GroupPublicKey) + `,`,
 		`GroupPrivateKeyShare:` + fmt.Sprintf("%v", this.GroupPrivateKeyShare) + `,`,
 		`GroupPublicKeyShares:` + mapStringForGroupPublicKeyShares + `,`,
+		`HonestThreshold:` + fmt.Sprintf("%v", this.HonestThreshold) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -720,6 +741,25 @@ func (m *ThresholdSigner) Unmarshal(dAtA []byte) error {
 			}
 			m.GroupPublicKeyShares[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HonestThreshold", wireType)
+			}
+			m.HonestThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HonestThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
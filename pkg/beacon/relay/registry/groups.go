@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
 )
@@ -70,12 +72,174 @@ func (g *Groups) RegisterGroup(
 	return nil
 }
 
-// GetGroup gets a group by a groupPublicKey
+// GetGroup gets a group by a groupPublicKey. The returned slice is a copy so
+// it is safe to iterate over it while new memberships are being registered.
 func (g *Groups) GetGroup(groupPublicKey []byte) []*Membership {
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
 
-	return g.myGroups[groupKeyToString(groupPublicKey)]
+	memberships, ok := g.myGroups[groupKeyToString(groupPublicKey)]
+	if !ok {
+		return nil
+	}
+
+	result := make([]*Membership, len(memberships))
+	copy(result, memberships)
+
+	return result
+}
+
+// GroupPublicKeys returns public keys of all groups the given client is
+// a member of. Keys are returned in their uncompressed form and are sorted
+// in ascending order so the result is stable between calls.
+func (g *Groups) GroupPublicKeys() [][]byte {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	groupPublicKeys := make([]string, 0, len(g.myGroups))
+	for groupPublicKey := range g.myGroups {
+		groupPublicKeys = append(groupPublicKeys, groupPublicKey)
+	}
+	sort.Strings(groupPublicKeys)
+
+	result := make([][]byte, 0, len(groupPublicKeys))
+	for _, groupPublicKey := range groupPublicKeys {
+		groupPublicKeyBytes, err := groupKeyFromString(groupPublicKey)
+		if err != nil {
+			logger.Errorf(
+				"error occurred while decoding public key into bytes: [%v]",
+				err,
+			)
+			continue
+		}
+
+		result = append(result, groupPublicKeyBytes)
+	}
+
+	return result
+}
+
+// GroupCount returns the number of groups the given client is a member of.
+func (g *Groups) GroupCount() int {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return len(g.myGroups)
+}
+
+// RemoveMembership removes the membership of the member with the given index
+// in the group with the given public key, for example when the group key has
+// been retired or the member has been slashed. Share of the group private key
+// held by the membership is wiped. Once the last membership of the group is
+// removed, the group public key is unregistered.
+//
+// The underlying storage keeps all memberships of a group together so the
+// stored group is archived and the remaining memberships are saved again.
+// Before the group is archived, the stored removed membership is overwritten
+// with one holding a wiped share, so the share is not kept in the archive.
+// The registry is updated only once the storage has been updated.
+func (g *Groups) RemoveMembership(
+	groupPublicKey []byte,
+	memberIndex group.MemberIndex,
+) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	groupKey := groupKeyToString(groupPublicKey)
+
+	var removed *Membership
+	remaining := make([]*Membership, 0)
+	for _, membership := range g.myGroups[groupKey] {
+		if removed == nil && membership.Signer.MemberID() == memberIndex {
+			removed = membership
+			continue
+		}
+		remaining = append(remaining, membership)
+	}
+
+	if removed == nil {
+		return fmt.Errorf(
+			"no membership of member [%v] in group [0x%x]",
+			memberIndex,
+			groupPublicKey,
+		)
+	}
+
+	wiped, err := wipedCopy(removed)
+	if err != nil {
+		return fmt.Errorf("could not wipe removed membership: [%v]", err)
+	}
+	if err := g.storage.save(wiped); err != nil {
+		return fmt.Errorf(
+			"could not persist wiped membership to the storage: [%v]",
+			err,
+		)
+	}
+
+	compressedPublicKey := removed.Signer.GroupPublicKeyBytesCompressed()
+	if err := g.storage.archive(compressedPublicKey); err != nil {
+		// The group stays registered so the removed membership has to be
+		// loaded with its share after a restart.
+		if restoreErr := g.storage.save(removed); restoreErr != nil {
+			logger.Errorf(
+				"could not restore membership of member [%v] in group "+
+					"with compressed public key [%s]: [%v]",
+				memberIndex,
+				hex.EncodeToString(compressedPublicKey),
+				restoreErr,
+			)
+		}
+
+		return fmt.Errorf(
+			"could not archive group with compressed public key [%s]: [%v]",
+			hex.EncodeToString(compressedPublicKey),
+			err,
+		)
+	}
+
+	for _, membership := range remaining {
+		if err := g.storage.save(membership); err != nil {
+			return fmt.Errorf(
+				"could not persist membership to the storage: [%v]",
+				err,
+			)
+		}
+	}
+
+	if len(remaining) == 0 {
+		delete(g.myGroups, groupKey)
+	} else {
+		g.myGroups[groupKey] = remaining
+	}
+
+	removed.Signer.Wipe()
+
+	logger.Infof(
+		"removed membership of member [%v] in group with compressed "+
+			"public key [%s]; [%v] memberships left",
+		memberIndex,
+		hex.EncodeToString(compressedPublicKey),
+		len(remaining),
+	)
+
+	return nil
+}
+
+// wipedCopy returns a copy of the given membership with the share of the group
+// private key wiped. The given membership is not modified.
+func wipedCopy(membership *Membership) (*Membership, error) {
+	membershipBytes, err := membership.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	wiped := &Membership{}
+	if err := wiped.Unmarshal(membershipBytes); err != nil {
+		return nil, err
+	}
+	wiped.Signer.Wipe()
+
+	return wiped, nil
 }
 
 // UnregisterStaleGroups lookup for groups that have been marked as stale
@@ -146,6 +310,9 @@ func (g *Groups) UnregisterStaleGroups(latestGroupPublicKey []byte) {
 // LoadExistingGroups iterates over all stored memberships on disk and loads them
 // into memory
 func (g *Groups) LoadExistingGroups() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
 	g.myGroups = make(map[string][]*Membership)
 
 	membershipsChannel, errorsChannel := g.storage.readAll()
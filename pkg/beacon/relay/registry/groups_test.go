@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"reflect"
+	"sync"
 	"testing"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
@@ -75,6 +76,141 @@ func TestRegisterGroup(t *testing.T) {
 	}
 }
 
+func TestGroupPublicKeysAndCount(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200)).ThresholdRelay()
+
+	gr := NewGroupRegistry(chain, persistenceMock)
+
+	if gr.GroupCount() != 0 {
+		t.Fatalf(
+			"Unexpected number of groups \nExpected: [%+v]\nActual:   [%+v]",
+			0,
+			gr.GroupCount(),
+		)
+	}
+	if len(gr.GroupPublicKeys()) != 0 {
+		t.Fatalf(
+			"Unexpected number of group public keys \nExpected: [%+v]\nActual:   [%+v]",
+			0,
+			len(gr.GroupPublicKeys()),
+		)
+	}
+
+	gr.RegisterGroup(signer1, channelName1)
+	gr.RegisterGroup(signer2, channelName2)
+	gr.RegisterGroup(signer3, channelName1)
+	// signer4 is a member of the same group as signer2
+	gr.RegisterGroup(signer4, channelName2)
+
+	if gr.GroupCount() != 3 {
+		t.Fatalf(
+			"Unexpected number of groups \nExpected: [%+v]\nActual:   [%+v]",
+			3,
+			gr.GroupCount(),
+		)
+	}
+
+	groupPublicKeys := gr.GroupPublicKeys()
+	if len(groupPublicKeys) != gr.GroupCount() {
+		t.Fatalf(
+			"Unexpected number of group public keys \nExpected: [%+v]\nActual:   [%+v]",
+			gr.GroupCount(),
+			len(groupPublicKeys),
+		)
+	}
+
+	for _, signer := range []*dkg.ThresholdSigner{signer1, signer2, signer3} {
+		found := false
+		for _, groupPublicKey := range groupPublicKeys {
+			if bytes.Equal(groupPublicKey, signer.GroupPublicKeyBytes()) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf(
+				"public key of group of member [%v] not found",
+				signer.MemberID(),
+			)
+		}
+	}
+
+	for i := 1; i < len(groupPublicKeys); i++ {
+		if bytes.Compare(groupPublicKeys[i-1], groupPublicKeys[i]) >= 0 {
+			t.Fatalf("group public keys are not sorted")
+		}
+	}
+}
+
+func TestConcurrentRegisterAndGetGroup(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200)).ThresholdRelay()
+
+	gr := NewGroupRegistry(chain, persistenceMock)
+
+	groupsCount := 10
+	membersPerGroup := 20
+
+	var signers []*dkg.ThresholdSigner
+	for i := 1; i <= groupsCount; i++ {
+		for j := 1; j <= membersPerGroup; j++ {
+			signers = append(signers, dkg.NewThresholdSigner(
+				group.MemberIndex(j),
+				new(bn256.G2).ScalarBaseMult(big.NewInt(int64(i))),
+				big.NewInt(int64(j)),
+				groupPublicKeyShares,
+			))
+		}
+	}
+
+	// Marshalling a point normalizes it in place so group public keys are
+	// marshalled upfront, before signers are shared between goroutines.
+	groupPublicKeys := make([][]byte, len(signers))
+	for i, signer := range signers {
+		groupPublicKeys[i] = signer.GroupPublicKeyBytes()
+	}
+
+	var wg sync.WaitGroup
+	for i, signer := range signers {
+		wg.Add(2)
+
+		go func(signer *dkg.ThresholdSigner) {
+			defer wg.Done()
+			gr.RegisterGroup(signer, channelName1)
+		}(signer)
+
+		go func(groupPublicKey []byte) {
+			defer wg.Done()
+			for _, membership := range gr.GetGroup(groupPublicKey) {
+				if membership.ChannelName != channelName1 {
+					t.Errorf("unexpected channel name [%v]", membership.ChannelName)
+				}
+			}
+		}(groupPublicKeys[i])
+	}
+	wg.Wait()
+
+	if gr.GroupCount() != groupsCount {
+		t.Fatalf(
+			"Unexpected number of groups \nExpected: [%+v]\nActual:   [%+v]",
+			groupsCount,
+			gr.GroupCount(),
+		)
+	}
+
+	for i := 1; i <= groupsCount; i++ {
+		groupPublicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(int64(i)))
+		memberships := gr.GetGroup(groupPublicKey.Marshal())
+		if len(memberships) != membersPerGroup {
+			t.Errorf(
+				"Unexpected number of group memberships \nExpected: [%+v]\nActual:   [%+v]",
+				membersPerGroup,
+				len(memberships),
+			)
+		}
+	}
+}
+
 func TestLoadGroup(t *testing.T) {
 	chain := chainLocal.Connect(5, 3, big.NewInt(200)).ThresholdRelay()
 	gr := NewGroupRegistry(chain, persistenceMock)
@@ -182,6 +318,105 @@ func TestUnregisterStaleGroupsSkipLastGroupCheck(t *testing.T) {
 	}
 }
 
+func TestRemoveMembership(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200)).ThresholdRelay()
+	persistence := &persistenceHandleMock{}
+
+	gr := NewGroupRegistry(chain, persistence)
+
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(40))
+	removedSigner := dkg.NewThresholdSigner(
+		group.MemberIndex(1),
+		groupPublicKey,
+		big.NewInt(4),
+		groupPublicKeyShares,
+	)
+	remainingSigner := dkg.NewThresholdSigner(
+		group.MemberIndex(2),
+		groupPublicKey,
+		big.NewInt(5),
+		groupPublicKeyShares,
+	)
+	gr.RegisterGroup(removedSigner, channelName1)
+	gr.RegisterGroup(remainingSigner, channelName1)
+
+	err := gr.RemoveMembership(groupPublicKey.Marshal(), group.MemberIndex(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberships := gr.GetGroup(groupPublicKey.Marshal())
+	if len(memberships) != 1 || memberships[0].Signer.MemberID() != 2 {
+		t.Fatalf("only membership of member [2] was expected to remain")
+	}
+
+	message := new(bn256.G1).ScalarBaseMult(big.NewInt(1337))
+	if _, err := removedSigner.CalculateSignatureShare(message); err == nil {
+		t.Errorf("share of the removed membership was expected to be wiped")
+	}
+	if _, err := remainingSigner.CalculateSignatureShare(message); err != nil {
+		t.Errorf("share of the remaining membership was not expected to be wiped")
+	}
+
+	groupDirectory := hex.EncodeToString(
+		removedSigner.GroupPublicKeyBytesCompressed(),
+	)
+
+	archivedMembership := &Membership{}
+	err = archivedMembership.Unmarshal(
+		persistence.archivedData[groupDirectory]["/membership_1"],
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := archivedMembership.Signer.CalculateSignatureShare(
+		message,
+	); err == nil {
+		t.Errorf("share of the removed membership was expected to be " +
+			"wiped in the archive")
+	}
+
+	if _, ok := persistence.savedData[groupDirectory]["/membership_1"]; ok {
+		t.Errorf("removed membership was not expected to be saved again")
+	}
+	if _, ok := persistence.savedData[groupDirectory]["/membership_2"]; !ok {
+		t.Errorf("remaining membership was expected to be saved again")
+	}
+
+	err = gr.RemoveMembership(groupPublicKey.Marshal(), group.MemberIndex(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gr.GetGroup(groupPublicKey.Marshal()) != nil {
+		t.Fatalf("group was expected to be unregistered")
+	}
+	if gr.GroupCount() != 0 {
+		t.Fatalf(
+			"unexpected number of groups\nexpected: %v\nactual:   %v",
+			0,
+			gr.GroupCount(),
+		)
+	}
+
+	expectedArchivedGroups := []string{
+		hex.EncodeToString(removedSigner.GroupPublicKeyBytesCompressed()),
+		hex.EncodeToString(removedSigner.GroupPublicKeyBytesCompressed()),
+	}
+	if !reflect.DeepEqual(expectedArchivedGroups, persistence.archivedGroups) {
+		t.Errorf(
+			"unexpected archived groups\nexpected: %v\nactual:   %v",
+			expectedArchivedGroups,
+			persistence.archivedGroups,
+		)
+	}
+
+	err = gr.RemoveMembership(groupPublicKey.Marshal(), group.MemberIndex(2))
+	if err == nil {
+		t.Fatal("expected an error when removing a non-existent membership")
+	}
+}
+
 type mockGroupRegistrationInterface struct {
 	groupsToRemove       [][]byte
 	groupsCheckedIfStale map[string]bool
@@ -213,12 +448,31 @@ func (mgri *mockGroupRegistrationInterface) GetGroupMembers(
 	return nil, nil // no-op
 }
 
+func (mgri *mockGroupRegistrationInterface) IsGroupMemberActive(
+	groupPublicKey []byte,
+	memberIndex chain.GroupMemberIndex,
+) (bool, error) {
+	return false, nil // no-op
+}
+
 type persistenceHandleMock struct {
 	archivedGroups []string
+
+	// saved data by directory and name; data of archived directories is moved
+	// to archivedData
+	savedData    map[string]map[string][]byte
+	archivedData map[string]map[string][]byte
 }
 
 func (phm *persistenceHandleMock) Save(data []byte, directory string, name string) error {
-	// noop
+	if phm.savedData == nil {
+		phm.savedData = make(map[string]map[string][]byte)
+	}
+	if phm.savedData[directory] == nil {
+		phm.savedData[directory] = make(map[string][]byte)
+	}
+	phm.savedData[directory][name] = data
+
 	return nil
 }
 
@@ -259,6 +513,12 @@ func (phm *persistenceHandleMock) ReadAll() (<-chan persistence.DataDescriptor,
 func (phm *persistenceHandleMock) Archive(directory string) error {
 	phm.archivedGroups = append(phm.archivedGroups, directory)
 
+	if phm.archivedData == nil {
+		phm.archivedData = make(map[string]map[string][]byte)
+	}
+	phm.archivedData[directory] = phm.savedData[directory]
+	delete(phm.savedData, directory)
+
 	return nil
 }
 
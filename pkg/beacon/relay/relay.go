@@ -18,20 +18,24 @@ var logger = log.Logger("keep-relay")
 const maxGroupSize = 255
 
 // NewNode returns an empty Node with no group, zero group count, and a nil last
-// seen entry, tied to the given net.Provider.
+// seen entry, tied to the given net.Provider. At most maxConcurrentSigning
+// signing processes are executed by the node at the same time; a non-positive
+// value disables the limit.
 func NewNode(
 	staker chain.Staker,
 	netProvider net.Provider,
 	blockCounter chain.BlockCounter,
 	chainConfig *relayChain.Config,
 	groupRegistry *registry.Groups,
+	maxConcurrentSigning int,
 ) Node {
 	return Node{
-		Staker:        staker,
-		netProvider:   netProvider,
-		blockCounter:  blockCounter,
-		chainConfig:   chainConfig,
-		groupRegistry: groupRegistry,
+		Staker:         staker,
+		netProvider:    netProvider,
+		blockCounter:   blockCounter,
+		chainConfig:    chainConfig,
+		groupRegistry:  groupRegistry,
+		signingLimiter: newSigningLimiter(maxConcurrentSigning, blockCounter),
 	}
 }
 
@@ -89,7 +93,12 @@ func (n *Node) MonitorRelayEntry(
 // upon successfully completing it, submits the signature as a new relay entry.
 // Note that this function returns immediately after determining whether the
 // node is or is not a member of the requested group, and signature creation
-// and submission is performed in a background goroutine.
+// and submission is performed in a background goroutine. The number of
+// concurrently executed signing goroutines is limited by the node's signing
+// limiter; goroutines above the limit wait until they can start and are
+// dropped if the relay entry timed out in the meantime. The previous
+// entry is converted into the signed input with the function configured by
+// SetHashToSignInput.
 func (n *Node) GenerateRelayEntry(
 	previousEntry []byte,
 	relayChain relayChain.Interface,
@@ -131,24 +140,47 @@ func (n *Node) GenerateRelayEntry(
 		)
 	}
 
+	// All memberships belong to the same group, so members active on-chain
+	// are looked up once for the request instead of once per membership.
+	activeMembers, err := entry.ActiveGroupMembers(
+		relayChain,
+		memberships[0].Signer,
+	)
+	if err != nil {
+		logger.Errorf("could not get active group members: [%v]", err)
+		return
+	}
+
+	signInput := n.signInput(previousEntry)
+	relayEntryTimeoutBlock := startBlockHeight + n.chainConfig.RelayEntryTimeout
+
 	for _, member := range memberships {
-		go func(member *registry.Membership) {
-			err = entry.SignAndSubmit(
+		member := member
+
+		n.signingLimiter.run(relayEntryTimeoutBlock, func() {
+			err := entry.SignAndSubmit(
 				n.blockCounter,
 				channel,
 				relayChain,
+				groupPublicKey,
 				previousEntry,
+				signInput,
 				n.chainConfig.HonestThreshold,
 				member.Signer,
+				activeMembers,
 				startBlockHeight,
+				n.notifyEntryConfirmed,
 			)
 			if err != nil {
+				failure := entry.ClassifyFailure(err)
 				logger.Errorf(
-					"error creating threshold signature: [%v]",
+					"error creating threshold signature [%v]: [%v]",
+					failure,
 					err,
 				)
+				n.notifySigningFailure(failure)
 				return
 			}
-		}(member)
+		})
 	}
 }
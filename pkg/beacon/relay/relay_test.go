@@ -1,11 +1,19 @@
 package relay
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/altbn128"
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/entry"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
 )
 
@@ -121,3 +129,120 @@ func TestMonitorRelayEntryOnChain_EntryNotSubmitted(t *testing.T) {
 		)
 	}
 }
+
+func TestNodeSignInput(t *testing.T) {
+	previousEntry := new(bn256.G1).ScalarBaseMult(big.NewInt(1328472189)).Marshal()
+
+	customHashToSignInput := func(previousEntry []byte) []byte {
+		return altbn128.G1HashToPoint(crypto.Keccak256(previousEntry)).Marshal()
+	}
+
+	var tests = map[string]struct {
+		hashToSignInput   entry.HashToSignInput
+		expectedSignInput []byte
+	}{
+		"default": {
+			hashToSignInput:   nil,
+			expectedSignInput: previousEntry,
+		},
+		"custom": {
+			hashToSignInput:   customHashToSignInput,
+			expectedSignInput: customHashToSignInput(previousEntry),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			node := &Node{}
+			node.SetHashToSignInput(test.hashToSignInput)
+
+			signInput := node.signInput(previousEntry)
+			if !bytes.Equal(test.expectedSignInput, signInput) {
+				t.Errorf(
+					"unexpected sign input\nexpected: [%x]\nactual:   [%x]",
+					test.expectedSignInput,
+					signInput,
+				)
+			}
+
+			if _, err := new(bn256.G1).Unmarshal(signInput); err != nil {
+				t.Errorf("sign input is not a G1 point: [%v]", err)
+			}
+		})
+	}
+
+	customSignInput := customHashToSignInput(previousEntry)
+	if bytes.Equal(previousEntry, customSignInput) {
+		t.Errorf("custom sign input should differ from the previous entry")
+	}
+}
+
+func TestNodeRemoveMembership(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200))
+	groupRegistry := registry.NewGroupRegistry(
+		chain.ThresholdRelay(),
+		&persistenceHandleMock{},
+	)
+
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(10))
+	signer := dkg.NewThresholdSigner(
+		group.MemberIndex(3),
+		groupPublicKey,
+		big.NewInt(7),
+		make(map[group.MemberIndex]*bn256.G2),
+	)
+	if err := groupRegistry.RegisterGroup(signer, "test_channel"); err != nil {
+		t.Fatal(err)
+	}
+
+	node := NewNode(nil, nil, nil, nil, groupRegistry, 0)
+
+	err := node.RemoveMembership(groupPublicKey.Marshal(), group.MemberIndex(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if node.IsInGroup(groupPublicKey.Marshal()) {
+		t.Errorf("node was not expected to be in the group")
+	}
+	if memberships := groupRegistry.GetGroup(groupPublicKey.Marshal()); len(memberships) != 0 {
+		t.Errorf(
+			"unexpected number of memberships\nexpected: %v\nactual:   %v",
+			0,
+			len(memberships),
+		)
+	}
+
+	message := new(bn256.G1).ScalarBaseMult(big.NewInt(1337))
+	if _, err := signer.CalculateSignatureShare(message); err == nil {
+		t.Errorf("share of the removed membership was expected to be wiped")
+	}
+}
+
+func TestNodeNeedsGenesis(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200))
+	groupRegistry := registry.NewGroupRegistry(
+		chain.ThresholdRelay(),
+		&persistenceHandleMock{},
+	)
+
+	node := NewNode(nil, nil, nil, nil, groupRegistry, 0)
+
+	if !node.NeedsGenesis() {
+		t.Errorf("node with no groups was expected to need genesis")
+	}
+
+	signer := dkg.NewThresholdSigner(
+		group.MemberIndex(1),
+		new(bn256.G2).ScalarBaseMult(big.NewInt(10)),
+		big.NewInt(7),
+		make(map[group.MemberIndex]*bn256.G2),
+	)
+	if err := groupRegistry.RegisterGroup(signer, "test_channel"); err != nil {
+		t.Fatal(err)
+	}
+
+	if node.NeedsGenesis() {
+		t.Errorf("node with a registered group was not expected to need genesis")
+	}
+}
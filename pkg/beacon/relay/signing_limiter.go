@@ -0,0 +1,125 @@
+package relay
+
+import (
+	"sync/atomic"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// signingLimiter limits the number of signing processes executed by the node
+// concurrently. Under a burst of relay requests, signing processes exceeding
+// the limit are queued and started as soon as one of the running signing
+// processes completes. A queued signing process is dropped if its deadline
+// block has been reached while it waited, since the group is no longer able
+// to submit the entry by then.
+type signingLimiter struct {
+	// Number of signing processes started and not yet completed, including
+	// the queued ones. Kept first for 64-bit alignment of atomic operations.
+	inFlight int64
+
+	semaphore    chan struct{}
+	blockCounter chain.BlockCounter
+}
+
+// newSigningLimiter creates a new limiter allowing at most maxConcurrent
+// signing processes to be executed at the same time. If maxConcurrent is not
+// a positive number, signing processes are not limited. The block counter is
+// used to check whether a queued signing process is still worth executing; if
+// it is nil, queued signing processes are never dropped.
+func newSigningLimiter(
+	maxConcurrent int,
+	blockCounter chain.BlockCounter,
+) *signingLimiter {
+	if maxConcurrent <= 0 {
+		return &signingLimiter{}
+	}
+
+	return &signingLimiter{
+		semaphore:    make(chan struct{}, maxConcurrent),
+		blockCounter: blockCounter,
+	}
+}
+
+// run executes the provided signing function in a background goroutine as
+// soon as the concurrency limit allows for it. The function is queued if the
+// limit has been already reached and dropped, with a warning, if the deadline
+// block has been reached while it was queued.
+func (sl *signingLimiter) run(deadlineBlock uint64, sign func()) {
+	if sl == nil {
+		go sign()
+		return
+	}
+
+	atomic.AddInt64(&sl.inFlight, 1)
+
+	if sl.semaphore == nil {
+		go func() {
+			defer atomic.AddInt64(&sl.inFlight, -1)
+			sign()
+		}()
+		return
+	}
+
+	go func() {
+		defer atomic.AddInt64(&sl.inFlight, -1)
+
+		select {
+		case sl.semaphore <- struct{}{}:
+		default:
+			logger.Warningf(
+				"reached the limit of [%v] concurrent signing processes; "+
+					"signing process queued",
+				cap(sl.semaphore),
+			)
+			sl.semaphore <- struct{}{}
+
+			if sl.isPastDeadline(deadlineBlock) {
+				<-sl.semaphore
+				return
+			}
+		}
+		defer func() { <-sl.semaphore }()
+
+		sign()
+	}()
+}
+
+// isPastDeadline checks whether the current block has reached the given
+// deadline block. If the current block can not be determined, the signing
+// process is not considered to be past its deadline.
+func (sl *signingLimiter) isPastDeadline(deadlineBlock uint64) bool {
+	if sl.blockCounter == nil {
+		return false
+	}
+
+	currentBlock, err := sl.blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Warningf(
+			"could not check the deadline of a queued signing process: [%v]",
+			err,
+		)
+		return false
+	}
+
+	if currentBlock < deadlineBlock {
+		return false
+	}
+
+	logger.Warningf(
+		"dropping queued signing process; deadline block [%v] has been "+
+			"reached while waiting, current block is [%v]",
+		deadlineBlock,
+		currentBlock,
+	)
+	return true
+}
+
+// inFlightCount returns the number of signing processes started and not yet
+// completed, including the queued ones.
+func (sl *signingLimiter) inFlightCount() int {
+	if sl == nil {
+		return 0
+	}
+
+	return int(atomic.LoadInt64(&sl.inFlight))
+}
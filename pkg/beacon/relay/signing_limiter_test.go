@@ -0,0 +1,141 @@
+package relay
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+func TestSigningLimiterRespectsConcurrencyLimit(t *testing.T) {
+	maxConcurrent := 3
+	requests := 20
+
+	limiter := newSigningLimiter(maxConcurrent, nil)
+
+	var running, maxRunning int32
+	var completed sync.WaitGroup
+	completed.Add(requests)
+
+	for i := 0; i < requests; i++ {
+		limiter.run(0, func() {
+			defer completed.Done()
+
+			current := atomic.AddInt32(&running, 1)
+			for {
+				observed := atomic.LoadInt32(&maxRunning)
+				if current <= observed ||
+					atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		})
+	}
+
+	completed.Wait()
+
+	if maxRunning > int32(maxConcurrent) {
+		t.Errorf(
+			"unexpected number of concurrent signing processes\n"+
+				"expected: <= %v\nactual:   %v\n",
+			maxConcurrent,
+			maxRunning,
+		)
+	}
+	if maxRunning < int32(maxConcurrent) {
+		t.Errorf(
+			"signing processes not executed concurrently\n"+
+				"expected: %v\nactual:   %v\n",
+			maxConcurrent,
+			maxRunning,
+		)
+	}
+}
+
+func TestSigningLimiterNoLimit(t *testing.T) {
+	requests := 10
+
+	limiter := newSigningLimiter(0, nil)
+
+	var started sync.WaitGroup
+	started.Add(requests)
+	release := make(chan struct{})
+
+	for i := 0; i < requests; i++ {
+		limiter.run(0, func() {
+			started.Done()
+			<-release
+		})
+	}
+
+	// All signing processes must start without waiting for any of them
+	// to complete.
+	started.Wait()
+	close(release)
+}
+
+func TestSigningLimiterDropsQueuedPastDeadline(t *testing.T) {
+	blockCounter := &signingBlockCounter{currentBlock: 10}
+	limiter := newSigningLimiter(1, blockCounter)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	limiter.run(20, func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	var signedPastDeadline, signedBeforeDeadline int32
+	var completed sync.WaitGroup
+	completed.Add(1)
+
+	limiter.run(15, func() {
+		atomic.StoreInt32(&signedPastDeadline, 1)
+	})
+	limiter.run(30, func() {
+		defer completed.Done()
+		atomic.StoreInt32(&signedBeforeDeadline, 1)
+	})
+
+	// give the signing processes a chance to queue up
+	time.Sleep(50 * time.Millisecond)
+
+	blockCounter.setCurrentBlock(15)
+	close(release)
+
+	completed.Wait()
+
+	if atomic.LoadInt32(&signedPastDeadline) != 0 {
+		t.Errorf("signing process past its deadline was not dropped")
+	}
+	if atomic.LoadInt32(&signedBeforeDeadline) != 1 {
+		t.Errorf("signing process before its deadline was not executed")
+	}
+}
+
+type signingBlockCounter struct {
+	chain.BlockCounter
+
+	mutex        sync.Mutex
+	currentBlock uint64
+}
+
+func (sbc *signingBlockCounter) setCurrentBlock(block uint64) {
+	sbc.mutex.Lock()
+	defer sbc.mutex.Unlock()
+
+	sbc.currentBlock = block
+}
+
+func (sbc *signingBlockCounter) CurrentBlock() (uint64, error) {
+	sbc.mutex.Lock()
+	defer sbc.mutex.Unlock()
+
+	return sbc.currentBlock, nil
+}
@@ -40,15 +40,36 @@ func NewMachine(
 
 // Execute state machine starting with initial state up to finalization. It
 // requires the broadcast channel to be pre-initialized.
-func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
+//
+// Execution is stopped as soon as the provided context is done. In such case,
+// the state in which the execution was stopped is returned along with an error
+// wrapping the context error so that the caller can clean up after that state.
+func (m *Machine) Execute(
+	ctx context.Context,
+	startBlockHeight uint64,
+) (State, uint64, error) {
 	recvChan := make(chan net.Message, receiveBuffer)
 	handler := func(msg net.Message) {
 		recvChan <- msg
 	}
 
 	currentState := m.initialState
-	ctx, cancelCtx := context.WithCancel(context.Background())
-	m.channel.Recv(ctx, handler)
+
+	cancelled := func() (State, uint64, error) {
+		logger.Warningf(
+			"[member:%v,channel:%s,state:%T] execution cancelled",
+			currentState.MemberIndex(),
+			m.channel.Name()[:5],
+			currentState,
+		)
+		return currentState, 0, fmt.Errorf(
+			"execution cancelled: [%w]",
+			ctx.Err(),
+		)
+	}
+
+	stateCtx, cancelStateCtx := context.WithCancel(ctx)
+	m.channel.Recv(stateCtx, handler)
 
 	logger.Infof(
 		"[member:%v,channel:%s] waiting for block %v to start execution",
@@ -56,27 +77,38 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 		m.channel.Name()[:5],
 		startBlockHeight,
 	)
-	err := m.blockCounter.WaitForBlockHeight(startBlockHeight)
+	err := waitForBlockHeight(ctx, m.blockCounter, startBlockHeight)
 	if err != nil {
+		cancelStateCtx()
+		if ctx.Err() != nil {
+			return cancelled()
+		}
 		return nil, 0, fmt.Errorf("failed to wait for the execution start block")
 	}
 
 	lastStateEndBlockHeight := startBlockHeight
 
 	blockWaiter, err := stateTransition(
-		ctx,
+		stateCtx,
 		currentState,
 		lastStateEndBlockHeight,
 		m.blockCounter,
 		m.channel.Name()[:5],
 	)
 	if err != nil {
-		cancelCtx()
+		cancelStateCtx()
+		if ctx.Err() != nil {
+			return cancelled()
+		}
 		return nil, 0, err
 	}
 
 	for {
 		select {
+		case <-ctx.Done():
+			cancelStateCtx()
+			return cancelled()
+
 		case msg := <-recvChan:
 			err := currentState.Receive(msg)
 			if err != nil {
@@ -90,7 +122,7 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 			}
 
 		case lastStateEndBlockHeight := <-blockWaiter:
-			cancelCtx()
+			cancelStateCtx()
 			nextState := currentState.Next()
 			if nextState == nil {
 				logger.Infof(
@@ -104,18 +136,21 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 			}
 
 			currentState = nextState
-			ctx, cancelCtx = context.WithCancel(context.Background())
-			m.channel.Recv(ctx, handler)
+			stateCtx, cancelStateCtx = context.WithCancel(ctx)
+			m.channel.Recv(stateCtx, handler)
 
 			blockWaiter, err = stateTransition(
-				ctx,
+				stateCtx,
 				currentState,
 				lastStateEndBlockHeight,
 				m.blockCounter,
 				m.channel.Name()[:5],
 			)
 			if err != nil {
-				cancelCtx()
+				cancelStateCtx()
+				if ctx.Err() != nil {
+					return cancelled()
+				}
 				return nil, 0, err
 			}
 
@@ -124,6 +159,26 @@ func (m *Machine) Execute(startBlockHeight uint64) (State, uint64, error) {
 	}
 }
 
+// waitForBlockHeight blocks until the given block height is reached or
+// the provided context is done, whichever comes first.
+func waitForBlockHeight(
+	ctx context.Context,
+	blockCounter chain.BlockCounter,
+	blockHeight uint64,
+) error {
+	blockWaiter, err := blockCounter.BlockHeightWaiter(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-blockWaiter:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func stateTransition(
 	ctx context.Context,
 	currentState State,
@@ -144,10 +199,10 @@ func stateTransition(
 	// This is needed when, for example, during the initialization some
 	// state-specific messages are sent.
 	initiateDelay := lastStateEndBlockHeight + currentState.DelayBlocks()
-	err := blockCounter.WaitForBlockHeight(initiateDelay)
+	err := waitForBlockHeight(ctx, blockCounter, initiateDelay)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"failed to wait [%v] blocks entering state [%T]: [%v]",
+			"failed to wait [%v] blocks entering state [%T]: [%w]",
 			currentState.DelayBlocks(),
 			currentState,
 			err,
@@ -156,7 +211,7 @@ func stateTransition(
 
 	err = currentState.Initiate(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initiate new state [%v]", err)
+		return nil, fmt.Errorf("failed to initiate new state [%w]", err)
 	}
 
 	blockWaiter, err := blockCounter.BlockHeightWaiter(
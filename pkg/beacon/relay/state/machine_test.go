@@ -2,10 +2,12 @@ package state
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -56,7 +58,7 @@ func TestExecute(t *testing.T) {
 
 	stateMachine := NewMachine(channel, blockCounter, initialState)
 
-	finalState, endBlockHeight, err := stateMachine.Execute(1)
+	finalState, endBlockHeight, err := stateMachine.Execute(context.Background(), 1)
 	if err != nil {
 		t.Errorf("unexpected error [%v]", err)
 	}
@@ -93,6 +95,66 @@ func TestExecute(t *testing.T) {
 	}
 }
 
+func TestExecuteCancelled(t *testing.T) {
+	testLog = make(map[uint64][]string)
+
+	localChain := chainLocal.Connect(10, 5, big.NewInt(200))
+	blockCounter, _ = localChain.BlockCounter()
+	provider := netLocal.Connect()
+	channel, err := provider.BroadcastChannelFor("transitions_cancel_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channel.SetUnmarshaler(func() net.TaggedUnmarshaler {
+		return &TestMessage{}
+	})
+
+	initialState := testState1{
+		memberIndex: group.MemberIndex(1),
+		channel:     channel,
+	}
+
+	stateMachine := NewMachine(channel, blockCounter, initialState)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		blockCounter.WaitForBlockHeight(2)
+		cancelCtx()
+	}()
+
+	type executionResult struct {
+		state State
+		err   error
+	}
+	resultChan := make(chan *executionResult, 1)
+	go func() {
+		lastState, _, err := stateMachine.Execute(ctx, 1)
+		resultChan <- &executionResult{lastState, err}
+	}()
+
+	// Execution should stop long before reaching the final state at block 8.
+	select {
+	case result := <-resultChan:
+		if !errors.Is(result.err, context.Canceled) {
+			t.Errorf(
+				"unexpected error\nexpected: %v\nactual:   %v\n",
+				context.Canceled,
+				result.err,
+			)
+		}
+		if result.state == nil {
+			t.Errorf("expected the state in which execution was stopped")
+		}
+		if _, ok := result.state.(*testState5); ok {
+			t.Errorf("unexpected final state [%v]", result.state)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("execution has not been stopped after cancellation")
+	}
+}
+
 func addToTestLog(testState State, functionName string) {
 	currentBlock, _ := blockCounter.CurrentBlock()
 	testLog[currentBlock] = append(
@@ -0,0 +1,132 @@
+package relay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// NodeStatus is a read-only snapshot of the node state intended to be
+// serialized to JSON and exposed by monitoring endpoints.
+type NodeStatus struct {
+	StakerAddress        string        `json:"staker_address"`
+	GroupCount           int           `json:"group_count"`
+	Groups               []GroupStatus `json:"groups"`
+	LastSeenEntry        string        `json:"last_seen_entry"`
+	InFlightSigningCount int           `json:"in_flight_signing_count"`
+}
+
+// GroupStatus describes a group the node is a member of.
+type GroupStatus struct {
+	GroupPublicKey string              `json:"group_public_key"`
+	MemberIndexes  []group.MemberIndex `json:"member_indexes"`
+}
+
+// ObserveRelayEntry records the given relay entry as the last entry seen
+// by the node.
+func (n *Node) ObserveRelayEntry(relayEntry []byte) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.lastSeenEntry = append([]byte{}, relayEntry...)
+}
+
+// Snapshot returns the current state of the node. Taking the snapshot does not
+// interfere with the protocol execution.
+func (n *Node) Snapshot() NodeStatus {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	status := NodeStatus{
+		Groups:               make([]GroupStatus, 0),
+		InFlightSigningCount: n.signingLimiter.inFlightCount(),
+	}
+
+	if n.Staker != nil {
+		status.StakerAddress = fmt.Sprintf("0x%x", n.Staker.Address())
+	}
+
+	if n.lastSeenEntry != nil {
+		status.LastSeenEntry = fmt.Sprintf("0x%x", n.lastSeenEntry)
+	}
+
+	if n.groupRegistry == nil {
+		return status
+	}
+
+	for _, groupPublicKey := range n.groupRegistry.GroupPublicKeys() {
+		memberships := n.groupRegistry.GetGroup(groupPublicKey)
+
+		memberIndexes := make([]group.MemberIndex, 0, len(memberships))
+		for _, membership := range memberships {
+			memberIndexes = append(memberIndexes, membership.Signer.MemberID())
+		}
+		group.SortMemberIndexes(memberIndexes)
+
+		status.Groups = append(status.Groups, GroupStatus{
+			GroupPublicKey: fmt.Sprintf("0x%x", groupPublicKey),
+			MemberIndexes:  memberIndexes,
+		})
+	}
+	sort.Slice(status.Groups, func(i, j int) bool {
+		return status.Groups[i].GroupPublicKey < status.Groups[j].GroupPublicKey
+	})
+	status.GroupCount = len(status.Groups)
+
+	return status
+}
+
+// OperatorStatus is the node status extended with the current balance of
+// the operator. It is served as JSON by the status handler.
+type OperatorStatus struct {
+	NodeStatus
+	OperatorBalance string `json:"operator_balance"`
+}
+
+// statusHandler serves the current status of the node and the balance of
+// the operator as JSON.
+type statusHandler struct {
+	node    *Node
+	monitor chain.BalanceMonitor
+}
+
+// NewStatusHandler creates an http.Handler serving the status of the given
+// node together with the current operator balance read using the given
+// balance monitor, so that operators can wire it into their own mux. The
+// node state is read from a snapshot, so serving the status does not block
+// the protocol execution. If the monitor is nil or the balance could not be
+// read, the operator balance is left empty.
+func NewStatusHandler(node *Node, monitor chain.BalanceMonitor) http.Handler {
+	return &statusHandler{node, monitor}
+}
+
+func (sh *statusHandler) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	if request.Method != http.MethodGet {
+		writer.Header().Set("Allow", http.MethodGet)
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	status := OperatorStatus{NodeStatus: sh.node.Snapshot()}
+
+	if sh.monitor != nil {
+		balance, err := sh.monitor.Balance("")
+		if err != nil {
+			logger.Warningf("could not read operator balance: [%v]", err)
+		} else {
+			status.OperatorBalance = balance.Text(10)
+		}
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(status); err != nil {
+		logger.Errorf("could not write node status: [%v]", err)
+	}
+}
@@ -0,0 +1,215 @@
+package relay
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestNodeSnapshot(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200))
+	groupRegistry := registry.NewGroupRegistry(
+		chain.ThresholdRelay(),
+		&persistenceHandleMock{},
+	)
+
+	groupPublicKey1 := new(bn256.G2).ScalarBaseMult(big.NewInt(10))
+	groupPublicKey2 := new(bn256.G2).ScalarBaseMult(big.NewInt(20))
+	groupPublicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+
+	signers := []*dkg.ThresholdSigner{
+		dkg.NewThresholdSigner(3, groupPublicKey1, big.NewInt(1), groupPublicKeyShares),
+		dkg.NewThresholdSigner(1, groupPublicKey1, big.NewInt(2), groupPublicKeyShares),
+		dkg.NewThresholdSigner(2, groupPublicKey2, big.NewInt(3), groupPublicKeyShares),
+	}
+	for _, signer := range signers {
+		if err := groupRegistry.RegisterGroup(signer, "test_channel"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	node := NewNode(nil, nil, nil, nil, groupRegistry, 0)
+	node.ObserveRelayEntry([]byte{0x12, 0x34})
+
+	status := node.Snapshot()
+
+	expectedGroups := []GroupStatus{
+		{
+			GroupPublicKey: hexString(groupPublicKey1.Marshal()),
+			MemberIndexes:  []group.MemberIndex{1, 3},
+		},
+		{
+			GroupPublicKey: hexString(groupPublicKey2.Marshal()),
+			MemberIndexes:  []group.MemberIndex{2},
+		},
+	}
+	if expectedGroups[0].GroupPublicKey > expectedGroups[1].GroupPublicKey {
+		expectedGroups[0], expectedGroups[1] = expectedGroups[1], expectedGroups[0]
+	}
+
+	expectedStatus := NodeStatus{
+		GroupCount:           2,
+		Groups:               expectedGroups,
+		LastSeenEntry:        "0x1234",
+		InFlightSigningCount: 0,
+	}
+
+	if !reflect.DeepEqual(expectedStatus, status) {
+		t.Errorf(
+			"unexpected node status\nexpected: %+v\nactual:   %+v",
+			expectedStatus,
+			status,
+		)
+	}
+
+	if _, err := json.Marshal(status); err != nil {
+		t.Errorf("could not serialize node status: [%v]", err)
+	}
+}
+
+func TestStatusHandler(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200))
+	groupRegistry := registry.NewGroupRegistry(
+		chain.ThresholdRelay(),
+		&persistenceHandleMock{},
+	)
+
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(10))
+	signer := dkg.NewThresholdSigner(
+		2,
+		groupPublicKey,
+		big.NewInt(1),
+		make(map[group.MemberIndex]*bn256.G2),
+	)
+	if err := groupRegistry.RegisterGroup(signer, "test_channel"); err != nil {
+		t.Fatal(err)
+	}
+
+	node := NewNode(nil, nil, nil, nil, groupRegistry, 0)
+	node.ObserveRelayEntry([]byte{0x56, 0x78})
+
+	handler := NewStatusHandler(
+		&node,
+		&balanceMonitorMock{balance: big.NewInt(1200000000000000000)},
+	)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("unexpected status code [%v]", recorder.Code)
+	}
+
+	var response struct {
+		GroupCount      int    `json:"group_count"`
+		LastSeenEntry   string `json:"last_seen_entry"`
+		OperatorBalance string `json:"operator_balance"`
+		Groups          []struct {
+			GroupPublicKey string              `json:"group_public_key"`
+			MemberIndexes  []group.MemberIndex `json:"member_indexes"`
+		} `json:"groups"`
+	}
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+
+	if response.GroupCount != 1 {
+		t.Errorf("unexpected group count [%v]", response.GroupCount)
+	}
+	if len(response.Groups) != 1 ||
+		response.Groups[0].GroupPublicKey != hexString(groupPublicKey.Marshal()) ||
+		!reflect.DeepEqual(response.Groups[0].MemberIndexes, []group.MemberIndex{2}) {
+		t.Errorf("unexpected groups %+v", response.Groups)
+	}
+	if response.LastSeenEntry != "0x5678" {
+		t.Errorf("unexpected last seen entry [%v]", response.LastSeenEntry)
+	}
+	if response.OperatorBalance != "1200000000000000000" {
+		t.Errorf("unexpected operator balance [%v]", response.OperatorBalance)
+	}
+}
+
+func TestStatusHandlerBalanceReadFailure(t *testing.T) {
+	node := NewNode(nil, nil, nil, nil, nil, 0)
+
+	handler := NewStatusHandler(
+		&node,
+		&balanceMonitorMock{err: fmt.Errorf("connection refused")},
+	)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("unexpected status code [%v]", recorder.Code)
+	}
+
+	var response map[string]interface{}
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+
+	if response["operator_balance"] != "" {
+		t.Errorf("unexpected operator balance [%v]", response["operator_balance"])
+	}
+	if response["group_count"] != float64(0) {
+		t.Errorf("unexpected group count [%v]", response["group_count"])
+	}
+}
+
+type balanceMonitorMock struct {
+	balance *big.Int
+	err     error
+}
+
+func (bmm *balanceMonitorMock) Observe(
+	ctx context.Context,
+	address string,
+	alertThreshold *big.Int,
+	tick time.Duration,
+) {
+}
+
+func (bmm *balanceMonitorMock) Balance(address string) (*big.Int, error) {
+	return bmm.balance, bmm.err
+}
+
+func hexString(bytes []byte) string {
+	return "0x" + hex.EncodeToString(bytes)
+}
+
+type persistenceHandleMock struct{}
+
+func (phm *persistenceHandleMock) Save(data []byte, directory string, name string) error {
+	return nil
+}
+
+func (phm *persistenceHandleMock) Snapshot(data []byte, directory string, name string) error {
+	return nil
+}
+
+func (phm *persistenceHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	outputData := make(chan persistence.DataDescriptor)
+	outputErrors := make(chan error)
+	close(outputData)
+	close(outputErrors)
+	return outputData, outputErrors
+}
+
+func (phm *persistenceHandleMock) Archive(directory string) error {
+	return nil
+}
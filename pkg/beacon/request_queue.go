@@ -0,0 +1,198 @@
+package beacon
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+)
+
+// relayRequestQueue orders relay entry requests by the block at which they
+// have been observed and dispatches them to the handler in ascending block
+// order of the requests pending at the time of dispatch. Requests can arrive
+// out of order or in bursts, for example when the client receives multiple
+// blocks at once after a longer delay.
+//
+// Requests are identified by their previous entry. A request enqueued while
+// another request with the same previous entry is pending is coalesced with
+// it and the lower of their block numbers is kept. Once dispatched, a request
+// is remembered until the relay entry timeout block for it; a request with the
+// same previous entry observed before that block is a duplicate and it is
+// dropped. A request observed after that block is a new request for the timed
+// out entry and it is dispatched again.
+//
+// Each request is handled in its own goroutine. The worker waits for the
+// handler of the previous request before dispatching the next one, but no
+// longer than the handler timeout, so a slow handler can not hold up requests
+// observed after it. As a result, only the order in which handlers are started
+// is guaranteed; once a handler exceeds the timeout, it runs concurrently with
+// the handlers of the following requests.
+type relayRequestQueue struct {
+	mutex   sync.Mutex
+	pending []*queuedRelayRequest
+	// Timeout blocks of dispatched requests, by request identifier.
+	dispatched map[string]uint64
+
+	notify            chan struct{}
+	handler           func(request *event.Request)
+	handlerTimeout    time.Duration
+	relayEntryTimeout uint64
+}
+
+type queuedRelayRequest struct {
+	request     *event.Request
+	blockNumber uint64
+	id          string
+}
+
+func newRelayRequestQueue(
+	handler func(request *event.Request),
+	handlerTimeout time.Duration,
+	relayEntryTimeout uint64,
+) *relayRequestQueue {
+	return &relayRequestQueue{
+		pending:           make([]*queuedRelayRequest, 0),
+		dispatched:        make(map[string]uint64),
+		notify:            make(chan struct{}, 1),
+		handler:           handler,
+		handlerTimeout:    handlerTimeout,
+		relayEntryTimeout: relayEntryTimeout,
+	}
+}
+
+// enqueue adds the request observed at the given block to the queue.
+func (rrq *relayRequestQueue) enqueue(
+	request *event.Request,
+	blockNumber uint64,
+) {
+	id := hex.EncodeToString(request.PreviousEntry)
+
+	rrq.mutex.Lock()
+	defer rrq.mutex.Unlock()
+
+	for dispatchedID, timeoutBlock := range rrq.dispatched {
+		if timeoutBlock <= blockNumber {
+			delete(rrq.dispatched, dispatchedID)
+		}
+	}
+
+	if _, ok := rrq.dispatched[id]; ok {
+		logger.Warningf(
+			"relay entry request with previous entry [0x%x] "+
+				"observed at block [%v] has been already handled",
+			request.PreviousEntry,
+			blockNumber,
+		)
+		return
+	}
+
+	for _, queued := range rrq.pending {
+		if queued.id == id {
+			logger.Warningf(
+				"relay entry request with previous entry [0x%x] "+
+					"observed at block [%v] is already queued",
+				request.PreviousEntry,
+				blockNumber,
+			)
+
+			if blockNumber < queued.blockNumber {
+				queued.blockNumber = blockNumber
+				rrq.sortPending()
+			}
+			return
+		}
+	}
+
+	rrq.pending = append(rrq.pending, &queuedRelayRequest{
+		request:     request,
+		blockNumber: blockNumber,
+		id:          id,
+	})
+	rrq.sortPending()
+
+	select {
+	case rrq.notify <- struct{}{}:
+	default:
+		// the worker has been already notified
+	}
+}
+
+func (rrq *relayRequestQueue) sortPending() {
+	sort.SliceStable(rrq.pending, func(i, j int) bool {
+		return rrq.pending[i].blockNumber < rrq.pending[j].blockNumber
+	})
+}
+
+// next removes the request with the lowest block number from the queue,
+// marks it as dispatched until its relay entry timeout block and returns it. It returns false if there are no
+// pending requests.
+func (rrq *relayRequestQueue) next() (*queuedRelayRequest, bool) {
+	rrq.mutex.Lock()
+	defer rrq.mutex.Unlock()
+
+	if len(rrq.pending) == 0 {
+		return nil, false
+	}
+
+	queued := rrq.pending[0]
+	rrq.pending = rrq.pending[1:]
+	rrq.dispatched[queued.id] = queued.blockNumber + rrq.relayEntryTimeout
+
+	return queued, true
+}
+
+// dispatch runs the handler for the queued request in a separate goroutine
+// and waits until the handler returns, the handler timeout elapses or the
+// context is done, whichever comes first.
+func (rrq *relayRequestQueue) dispatch(
+	ctx context.Context,
+	queued *queuedRelayRequest,
+) {
+	handled := make(chan struct{})
+	go func() {
+		defer close(handled)
+
+		rrq.handler(queued.request)
+	}()
+
+	select {
+	case <-handled:
+	case <-time.After(rrq.handlerTimeout):
+		logger.Warningf(
+			"relay entry request with previous entry [0x%x] "+
+				"observed at block [%v] is still being handled after [%v]; "+
+				"dispatching next requests",
+			queued.request.PreviousEntry,
+			queued.blockNumber,
+			rrq.handlerTimeout,
+		)
+	case <-ctx.Done():
+	}
+}
+
+// start runs the worker dispatching queued requests to the handler until
+// the context is done. Pending requests are dispatched in ascending block
+// order; the next request is dispatched once the handler returns for the
+// previous one or the handler timeout elapses.
+func (rrq *relayRequestQueue) start(ctx context.Context) {
+	go func() {
+		for {
+			for queued, ok := rrq.next(); ok; queued, ok = rrq.next() {
+				if ctx.Err() != nil {
+					return
+				}
+
+				rrq.dispatch(ctx, queued)
+			}
+
+			select {
+			case <-rrq.notify:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
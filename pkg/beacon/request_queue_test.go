@@ -0,0 +1,144 @@
+package beacon
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+)
+
+func TestRelayRequestQueueDispatchesInBlockOrder(t *testing.T) {
+	handledChannel := make(chan *event.Request, 10)
+	queue := newRelayRequestQueue(func(request *event.Request) {
+		handledChannel <- request
+	}, time.Second, 100)
+
+	newRequest := func(previousEntry byte, blockNumber uint64) *event.Request {
+		return &event.Request{
+			PreviousEntry: []byte{previousEntry},
+			BlockNumber:   blockNumber,
+		}
+	}
+
+	queue.enqueue(newRequest(0x03, 30), 30)
+	queue.enqueue(newRequest(0x01, 10), 10)
+	queue.enqueue(newRequest(0x04, 40), 40)
+	queue.enqueue(newRequest(0x01, 10), 10) // duplicate
+	queue.enqueue(newRequest(0x02, 20), 20)
+	queue.enqueue(newRequest(0x03, 30), 35) // duplicate observed later
+	queue.enqueue(newRequest(0x04, 40), 15) // duplicate observed earlier
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	queue.start(ctx)
+
+	expectedPreviousEntries := [][]byte{{0x01}, {0x04}, {0x02}, {0x03}}
+	assertHandled(t, handledChannel, expectedPreviousEntries)
+
+	// requests enqueued once the worker is running are dispatched as well
+	queue.enqueue(newRequest(0x06, 60), 60)
+	assertHandled(t, handledChannel, [][]byte{{0x06}})
+}
+
+func TestRelayRequestQueueStopsWithContext(t *testing.T) {
+	handledChannel := make(chan *event.Request, 10)
+	queue := newRelayRequestQueue(func(request *event.Request) {
+		handledChannel <- request
+	}, time.Second, 100)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	queue.start(ctx)
+	cancelCtx()
+
+	// give the worker a chance to exit
+	time.Sleep(50 * time.Millisecond)
+
+	queue.enqueue(&event.Request{PreviousEntry: []byte{0x01}}, 10)
+
+	select {
+	case request := <-handledChannel:
+		t.Fatalf(
+			"unexpected request with previous entry [%v] handled",
+			request.PreviousEntry,
+		)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRelayRequestQueueDoesNotWaitForSlowHandler(t *testing.T) {
+	handledChannel := make(chan *event.Request, 10)
+	releaseChannel := make(chan struct{})
+	queue := newRelayRequestQueue(func(request *event.Request) {
+		handledChannel <- request
+		if request.PreviousEntry[0] == 0x01 {
+			<-releaseChannel
+		}
+	}, 50*time.Millisecond, 100)
+
+	queue.enqueue(&event.Request{PreviousEntry: []byte{0x01}}, 10)
+	queue.enqueue(&event.Request{PreviousEntry: []byte{0x02}}, 20)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	queue.start(ctx)
+
+	assertHandled(t, handledChannel, [][]byte{{0x01}, {0x02}})
+
+	// the request is still being handled so it is not dispatched again
+	queue.enqueue(&event.Request{PreviousEntry: []byte{0x01}}, 30)
+	assertHandled(t, handledChannel, [][]byte{})
+
+	close(releaseChannel)
+
+	// give the handler a chance to complete
+	time.Sleep(50 * time.Millisecond)
+
+	// the request has been handled and its relay entry has not timed out yet
+	// so it is not dispatched again
+	queue.enqueue(&event.Request{PreviousEntry: []byte{0x01}}, 40)
+	assertHandled(t, handledChannel, [][]byte{})
+
+	// the relay entry timed out so the request is dispatched again
+	queue.enqueue(&event.Request{PreviousEntry: []byte{0x01}}, 110)
+	assertHandled(t, handledChannel, [][]byte{{0x01}})
+}
+
+func assertHandled(
+	t *testing.T,
+	handledChannel <-chan *event.Request,
+	expectedPreviousEntries [][]byte,
+) {
+	handledPreviousEntries := make([][]byte, 0)
+	for range expectedPreviousEntries {
+		select {
+		case request := <-handledChannel:
+			handledPreviousEntries = append(
+				handledPreviousEntries,
+				request.PreviousEntry,
+			)
+		case <-time.After(time.Second):
+			t.Fatalf("request not handled on time")
+		}
+	}
+
+	if !reflect.DeepEqual(expectedPreviousEntries, handledPreviousEntries) {
+		t.Errorf(
+			"unexpected handled requests\nexpected: %v\nactual:   %v\n",
+			expectedPreviousEntries,
+			handledPreviousEntries,
+		)
+	}
+
+	select {
+	case request := <-handledChannel:
+		t.Errorf(
+			"unexpected request with previous entry [%v] handled",
+			request.PreviousEntry,
+		)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
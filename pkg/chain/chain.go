@@ -58,13 +58,20 @@ type StakeMonitor interface {
 type BalanceMonitor interface {
 	// Observe starts a process which checks the address balance with the given
 	// tick and triggers an alert in case the balance falls below the
-	// alert threshold value.
+	// alert threshold value. If the alert threshold is nil, the threshold
+	// configured for the chain is used. If the address is empty, the operator
+	// address derived from its static key is observed.
 	Observe(
 		ctx context.Context,
 		address string,
 		alertThreshold *big.Int,
 		tick time.Duration,
 	)
+
+	// Balance returns the current balance of the given address. If the
+	// address is empty, the balance of the operator address derived from its
+	// static key is returned.
+	Balance(address string) (*big.Int, error)
 }
 
 // Signing is an interface that provides ability to sign and verify
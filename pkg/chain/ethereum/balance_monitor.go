@@ -2,10 +2,14 @@ package ethereum
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/operator"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -13,26 +17,57 @@ import (
 // BalanceSource provides a balance info for the given address.
 type BalanceSource func(address common.Address) (*big.Int, error)
 
+// defaultBalanceAlertThreshold determines the alert threshold below which
+// the alert should be triggered if no threshold has been configured.
+var defaultBalanceAlertThreshold = big.NewInt(500000000000000000) // 0.5 ether
+
 // BalanceMonitor provides the possibility to monitor balances for given
 // accounts.
 type BalanceMonitor struct {
-	balanceSource BalanceSource
+	balanceSource         BalanceSource
+	defaultAlertThreshold *big.Int
+	defaultAddress        string
 }
 
-// NewBalanceMonitor creates a new instance of the balance monitor.
-func NewBalanceMonitor(balanceSource BalanceSource) *BalanceMonitor {
-	return &BalanceMonitor{balanceSource}
+// NewBalanceMonitor creates a new instance of the balance monitor. The default
+// alert threshold is used when Observe is called without an alert threshold
+// and the default address is used when Observe is called without an address.
+func NewBalanceMonitor(
+	balanceSource BalanceSource,
+	defaultAlertThreshold *big.Int,
+	defaultAddress string,
+) *BalanceMonitor {
+	return &BalanceMonitor{balanceSource, defaultAlertThreshold, defaultAddress}
 }
 
 // Observe starts a process which checks the address balance with the given
 // tick and triggers an alert in case the balance falls below the
-// alert threshold value.
+// alert threshold value. If the alert threshold is nil, the default alert
+// threshold of the monitor is used. If the address is empty, the default
+// address of the monitor is observed.
 func (bm *BalanceMonitor) Observe(
 	ctx context.Context,
 	address string,
 	alertThreshold *big.Int,
 	tick time.Duration,
 ) {
+	alertThreshold = bm.resolveAlertThreshold(alertThreshold)
+
+	if address == "" {
+		address = bm.defaultAddress
+	}
+	if address == "" {
+		logger.Errorf("no address to monitor the balance of")
+		return
+	}
+
+	logger.Infof(
+		"starting balance monitoring for address [%v] "+
+			"with the alert threshold set to [%v] wei",
+		address,
+		alertThreshold,
+	)
+
 	check := func() {
 		balance, err := bm.balanceSource(common.HexToAddress(address))
 		if err != nil {
@@ -65,6 +100,209 @@ func (bm *BalanceMonitor) Observe(
 	}()
 }
 
+// Balance returns the current balance of the given address. If the address
+// is empty, the balance of the default address of the monitor is returned.
+func (bm *BalanceMonitor) Balance(address string) (*big.Int, error) {
+	if address == "" {
+		address = bm.defaultAddress
+	}
+	if address == "" {
+		return nil, fmt.Errorf("no address to read the balance of")
+	}
+
+	return bm.balanceSource(common.HexToAddress(address))
+}
+
+// resolveAlertThreshold returns the provided alert threshold if it is set or
+// the default alert threshold of the monitor otherwise.
+func (bm *BalanceMonitor) resolveAlertThreshold(
+	alertThreshold *big.Int,
+) *big.Int {
+	if alertThreshold != nil {
+		return alertThreshold
+	}
+
+	return bm.defaultAlertThreshold
+}
+
+// BalanceMonitor returns a balance monitor using the balance alert threshold
+// from the chain config as the default alert threshold. If the threshold is
+// not configured, 0.5 ether is used. The monitor observes the operator
+// address derived from its static key by default.
 func (ec *ethereumChain) BalanceMonitor() (chain.BalanceMonitor, error) {
-	return NewBalanceMonitor(ec.WeiBalanceOf), nil
+	alertThreshold := defaultBalanceAlertThreshold
+	if ec.config.BalanceAlertThreshold != nil {
+		alertThreshold = ec.config.BalanceAlertThreshold.Int
+	}
+
+	if alertThreshold == nil || alertThreshold.Sign() <= 0 {
+		return nil, fmt.Errorf(
+			"balance alert threshold must be positive; has [%v]",
+			alertThreshold,
+		)
+	}
+
+	networkPrivateKey, _ := key.OperatorKeyToNetworkKey(
+		operator.ChainKeyToOperatorKey(ec.accountKey),
+	)
+	operatorAddress, err := key.NetworkKeyToChainAddress(networkPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not derive operator address: [%v]",
+			err,
+		)
+	}
+
+	return NewBalanceMonitor(
+		ec.balanceCache.balanceOf,
+		alertThreshold,
+		operatorAddress,
+	), nil
+}
+
+// defaultBalanceCacheTTL determines how long a balance read from the chain
+// is served from the cache.
+const defaultBalanceCacheTTL = 30 * time.Second
+
+// cachingBalanceSource wraps a BalanceSource and caches read balances for the
+// given time so that repeated reads of the same address, for example done by
+// multiple balance monitors, do not result in repeated calls to the chain.
+// Errors are not cached. cachingBalanceSource is safe for concurrent use.
+type cachingBalanceSource struct {
+	source BalanceSource
+	ttl    time.Duration
+
+	mutex    sync.Mutex
+	balances map[common.Address]*cachedBalance
+
+	now func() time.Time
+}
+
+type cachedBalance struct {
+	value     *big.Int
+	expiresAt time.Time
+}
+
+func newCachingBalanceSource(
+	source BalanceSource,
+	ttl time.Duration,
+) *cachingBalanceSource {
+	return &cachingBalanceSource{
+		source:   source,
+		ttl:      ttl,
+		balances: make(map[common.Address]*cachedBalance),
+		now:      time.Now,
+	}
+}
+
+// balanceOf returns the balance of the given address. The balance is read
+// from the cache if it has been read from the underlying source within the
+// TTL, otherwise it is read from the source and cached.
+func (cbs *cachingBalanceSource) balanceOf(
+	address common.Address,
+) (*big.Int, error) {
+	cbs.mutex.Lock()
+	cached, ok := cbs.balances[address]
+	cbs.mutex.Unlock()
+
+	if ok && cbs.now().Before(cached.expiresAt) {
+		return new(big.Int).Set(cached.value), nil
+	}
+
+	return cbs.freshBalanceOf(address)
+}
+
+// freshBalanceOf bypasses the cache and reads the balance of the given address
+// from the underlying source. The read balance replaces the cached one.
+func (cbs *cachingBalanceSource) freshBalanceOf(
+	address common.Address,
+) (*big.Int, error) {
+	balance, err := cbs.source(address)
+	if err != nil {
+		return nil, err
+	}
+
+	cbs.mutex.Lock()
+	cbs.balances[address] = &cachedBalance{
+		value:     new(big.Int).Set(balance),
+		expiresAt: cbs.now().Add(cbs.ttl),
+	}
+	cbs.mutex.Unlock()
+
+	return balance, nil
+}
+
+// defaultDegradedRPCThreshold determines the number of consecutive balance
+// read failures after which the RPC endpoint is considered degraded.
+const defaultDegradedRPCThreshold = 5
+
+// healthCheckedBalanceSource wraps a BalanceSource and tracks consecutive
+// read failures. Once the number of consecutive failures reaches the
+// threshold, a degraded RPC alert is triggered. The alert is triggered only
+// once until a balance is read successfully again. healthCheckedBalanceSource
+// is safe for concurrent use.
+type healthCheckedBalanceSource struct {
+	source            BalanceSource
+	degradedThreshold int
+	onDegraded        func(consecutiveFailures int, err error)
+
+	mutex               sync.Mutex
+	consecutiveFailures int
+	degraded            bool
+}
+
+// newHealthCheckedBalanceSource creates a balance source triggering the
+// degraded RPC alert after the given number of consecutive failures. If the
+// onDegraded handler is nil, the alert is only logged.
+func newHealthCheckedBalanceSource(
+	source BalanceSource,
+	degradedThreshold int,
+	onDegraded func(consecutiveFailures int, err error),
+) *healthCheckedBalanceSource {
+	return &healthCheckedBalanceSource{
+		source:            source,
+		degradedThreshold: degradedThreshold,
+		onDegraded:        onDegraded,
+	}
+}
+
+// balanceOf reads the balance of the given address from the underlying
+// source and updates the health of the source accordingly.
+func (hbs *healthCheckedBalanceSource) balanceOf(
+	address common.Address,
+) (*big.Int, error) {
+	balance, err := hbs.source(address)
+
+	hbs.mutex.Lock()
+	defer hbs.mutex.Unlock()
+
+	if err == nil {
+		if hbs.degraded {
+			logger.Infof(
+				"ethereum RPC recovered after [%v] consecutive "+
+					"balance read failures",
+				hbs.consecutiveFailures,
+			)
+		}
+		hbs.consecutiveFailures = 0
+		hbs.degraded = false
+		return balance, nil
+	}
+
+	hbs.consecutiveFailures++
+	if !hbs.degraded && hbs.consecutiveFailures >= hbs.degradedThreshold {
+		hbs.degraded = true
+
+		logger.Errorf(
+			"ethereum RPC degraded; [%v] consecutive balance read "+
+				"failures, last error: [%v]",
+			hbs.consecutiveFailures,
+			err,
+		)
+		if hbs.onDegraded != nil {
+			hbs.onDegraded(hbs.consecutiveFailures, err)
+		}
+	}
+
+	return nil, err
 }
@@ -0,0 +1,384 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+)
+
+func TestCachingBalanceSource(t *testing.T) {
+	address1 := common.HexToAddress("0x1")
+	address2 := common.HexToAddress("0x2")
+
+	var calls uint64
+	source := func(address common.Address) (*big.Int, error) {
+		atomic.AddUint64(&calls, 1)
+		return big.NewInt(100), nil
+	}
+
+	now := time.Now()
+	cache := newCachingBalanceSource(source, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		balance, err := cache.balanceOf(address1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.Cmp(big.NewInt(100)) != 0 {
+			t.Fatalf(
+				"unexpected balance\nexpected: %v\nactual:   %v",
+				100,
+				balance,
+			)
+		}
+	}
+	assertBalanceSourceCalls(t, 1, calls)
+
+	if _, err := cache.balanceOf(address2); err != nil {
+		t.Fatal(err)
+	}
+	assertBalanceSourceCalls(t, 2, calls)
+
+	if _, err := cache.freshBalanceOf(address1); err != nil {
+		t.Fatal(err)
+	}
+	assertBalanceSourceCalls(t, 3, calls)
+
+	now = now.Add(time.Minute)
+	if _, err := cache.balanceOf(address1); err != nil {
+		t.Fatal(err)
+	}
+	assertBalanceSourceCalls(t, 4, calls)
+}
+
+func TestCachingBalanceSourceDoesNotCacheErrors(t *testing.T) {
+	address := common.HexToAddress("0x1")
+
+	var calls uint64
+	source := func(address common.Address) (*big.Int, error) {
+		atomic.AddUint64(&calls, 1)
+		return nil, fmt.Errorf("connection lost")
+	}
+
+	cache := newCachingBalanceSource(source, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.balanceOf(address); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	assertBalanceSourceCalls(t, 3, calls)
+}
+
+func TestCachingBalanceSourceConcurrentReads(t *testing.T) {
+	address := common.HexToAddress("0x1")
+
+	var calls uint64
+	source := func(address common.Address) (*big.Int, error) {
+		atomic.AddUint64(&calls, 1)
+		return big.NewInt(100), nil
+	}
+
+	cache := newCachingBalanceSource(source, time.Minute)
+
+	// Warm up the cache so that all concurrent reads are served from it.
+	if _, err := cache.balanceOf(address); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.balanceOf(address); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assertBalanceSourceCalls(t, 1, calls)
+}
+
+func TestHealthCheckedBalanceSourceDegradedAlert(t *testing.T) {
+	address := common.HexToAddress("0x1")
+	degradedThreshold := 3
+
+	failing := true
+	source := func(address common.Address) (*big.Int, error) {
+		if failing {
+			return nil, fmt.Errorf("connection lost")
+		}
+		return big.NewInt(100), nil
+	}
+
+	alerts := 0
+	healthChecked := newHealthCheckedBalanceSource(
+		source,
+		degradedThreshold,
+		func(consecutiveFailures int, err error) {
+			alerts++
+			if consecutiveFailures != degradedThreshold {
+				t.Errorf(
+					"unexpected number of consecutive failures"+
+						"\nexpected: %v\nactual:   %v",
+					degradedThreshold,
+					consecutiveFailures,
+				)
+			}
+		},
+	)
+
+	read := func(times int) {
+		for i := 0; i < times; i++ {
+			_, err := healthChecked.balanceOf(address)
+			if failing && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !failing && err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	read(degradedThreshold - 1)
+	assertDegradedAlerts(t, 0, alerts)
+
+	// The alert is triggered once the threshold is reached and it is not
+	// repeated for subsequent failures.
+	read(degradedThreshold + 2)
+	assertDegradedAlerts(t, 1, alerts)
+
+	// A successful read resets the state so the alert is triggered again
+	// once the source degrades one more time.
+	failing = false
+	read(1)
+	failing = true
+	read(degradedThreshold - 1)
+	assertDegradedAlerts(t, 1, alerts)
+	read(1)
+	assertDegradedAlerts(t, 2, alerts)
+}
+
+func assertDegradedAlerts(t *testing.T, expected int, actual int) {
+	if expected != actual {
+		t.Fatalf(
+			"unexpected number of degraded RPC alerts\nexpected: %v\nactual:   %v",
+			expected,
+			actual,
+		)
+	}
+}
+
+func assertBalanceSourceCalls(t *testing.T, expected uint64, actual uint64) {
+	if expected != actual {
+		t.Fatalf(
+			"unexpected number of balance source calls\nexpected: %v\nactual:   %v",
+			expected,
+			actual,
+		)
+	}
+}
+
+func TestBalanceMonitorAlertThreshold(t *testing.T) {
+	source := func(address common.Address) (*big.Int, error) {
+		return big.NewInt(100), nil
+	}
+
+	var tests = map[string]struct {
+		configuredThreshold *ethereum.Wei
+		explicitThreshold   *big.Int
+		expectedThreshold   *big.Int
+		expectedError       error
+	}{
+		"threshold not configured": {
+			expectedThreshold: defaultBalanceAlertThreshold,
+		},
+		"threshold configured": {
+			configuredThreshold: &ethereum.Wei{Int: big.NewInt(1000)},
+			expectedThreshold:   big.NewInt(1000),
+		},
+		"explicit threshold overrides configured threshold": {
+			configuredThreshold: &ethereum.Wei{Int: big.NewInt(1000)},
+			explicitThreshold:   big.NewInt(2000),
+			expectedThreshold:   big.NewInt(2000),
+		},
+		"zero threshold configured": {
+			configuredThreshold: &ethereum.Wei{Int: big.NewInt(0)},
+			expectedError: fmt.Errorf(
+				"balance alert threshold must be positive; has [0]",
+			),
+		},
+		"negative threshold configured": {
+			configuredThreshold: &ethereum.Wei{Int: big.NewInt(-1)},
+			expectedError: fmt.Errorf(
+				"balance alert threshold must be positive; has [-1]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ec := &ethereumChain{
+				config: ethereum.Config{
+					BalanceAlertThreshold: test.configuredThreshold,
+				},
+				accountKey:   newTestAccountKey(t),
+				balanceCache: newCachingBalanceSource(source, time.Minute),
+			}
+
+			balanceMonitor, err := ec.BalanceMonitor()
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+			if test.expectedError != nil {
+				return
+			}
+
+			threshold := balanceMonitor.(*BalanceMonitor).resolveAlertThreshold(
+				test.explicitThreshold,
+			)
+			if threshold.Cmp(test.expectedThreshold) != 0 {
+				t.Errorf(
+					"unexpected alert threshold\nexpected: %v\nactual:   %v",
+					test.expectedThreshold,
+					threshold,
+				)
+			}
+		})
+	}
+}
+
+func TestBalanceMonitorObservesOperatorAddress(t *testing.T) {
+	observedAddresses := make(chan common.Address, 10)
+	source := func(address common.Address) (*big.Int, error) {
+		observedAddresses <- address
+		return big.NewInt(100), nil
+	}
+
+	ec := &ethereumChain{
+		accountKey:   newTestAccountKey(t),
+		balanceCache: newCachingBalanceSource(source, time.Minute),
+	}
+
+	balanceMonitor, err := ec.BalanceMonitor()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	balanceMonitor.Observe(ctx, "", nil, 10*time.Millisecond)
+
+	// Address derived from the static key of the test account.
+	expectedAddress := common.HexToAddress(
+		"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+	)
+
+	select {
+	case address := <-observedAddresses:
+		if address != expectedAddress {
+			t.Errorf(
+				"unexpected observed address\nexpected: %v\nactual:   %v",
+				expectedAddress.Hex(),
+				address.Hex(),
+			)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("balance has not been observed")
+	}
+}
+
+func TestBalanceMonitorBalance(t *testing.T) {
+	balances := map[common.Address]*big.Int{
+		common.HexToAddress("0x1"): big.NewInt(100),
+		common.HexToAddress("0x2"): big.NewInt(200),
+	}
+	source := func(address common.Address) (*big.Int, error) {
+		balance, ok := balances[address]
+		if !ok {
+			return nil, fmt.Errorf("unknown address [%v]", address.Hex())
+		}
+		return balance, nil
+	}
+
+	var tests = map[string]struct {
+		defaultAddress  string
+		address         string
+		expectedBalance *big.Int
+		expectedError   bool
+	}{
+		"default address": {
+			defaultAddress:  "0x1",
+			expectedBalance: big.NewInt(100),
+		},
+		"given address": {
+			defaultAddress:  "0x1",
+			address:         "0x2",
+			expectedBalance: big.NewInt(200),
+		},
+		"no address": {
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			balanceMonitor := NewBalanceMonitor(
+				source,
+				big.NewInt(1),
+				test.defaultAddress,
+			)
+
+			balance, err := balanceMonitor.Balance(test.address)
+			if test.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if balance.Cmp(test.expectedBalance) != 0 {
+				t.Errorf(
+					"unexpected balance\nexpected: %v\nactual:   %v",
+					test.expectedBalance,
+					balance,
+				)
+			}
+		})
+	}
+}
+
+func newTestAccountKey(t *testing.T) *keystore.Key {
+	privateKey, err := crypto.HexToECDSA(
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &keystore.Key{
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+}
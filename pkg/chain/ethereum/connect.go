@@ -35,6 +35,11 @@ var (
 	// allowed gas price is reached, no further resubmission attempts are
 	// performed. This value can be overwritten in the configuration file.
 	DefaultMaxGasPrice = big.NewInt(500000000000) // 500 Gwei
+
+	// RelayEntryConfirmationDepth is the number of blocks mined on top of the
+	// block with submitted relay entry after which the client checks if
+	// the entry has not been dropped by a chain reorganization.
+	RelayEntryConfirmationDepth uint64 = 12
 )
 
 type ethereumChain struct {
@@ -47,6 +52,13 @@ type ethereumChain struct {
 	accountKey                       *keystore.Key
 	blockCounter                     *ethlike.BlockCounter
 	chainConfig                      *relaychain.Config
+	balanceCache                     *cachingBalanceSource
+
+	// groupMembersCache holds addresses of group members by group public
+	// key. Members of a registered group never change, so they are fetched
+	// from the chain once per group.
+	groupMembersMutex *sync.Mutex
+	groupMembersCache map[string][]common.Address
 
 	// transactionMutex allows interested parties to forcibly serialize
 	// transaction submission.
@@ -89,11 +101,13 @@ func connectWithClient(
 	clientRPC *rpc.Client,
 ) (*ethereumChain, error) {
 	pv := &ethereumChain{
-		config:           config,
-		client:           addClientWrappers(config, client),
-		clientRPC:        clientRPC,
-		clientWS:         clientWS,
-		transactionMutex: &sync.Mutex{},
+		config:            config,
+		client:            addClientWrappers(config, client),
+		clientRPC:         clientRPC,
+		clientWS:          clientWS,
+		transactionMutex:  &sync.Mutex{},
+		groupMembersMutex: &sync.Mutex{},
+		groupMembersCache: make(map[string][]common.Address),
 	}
 
 	blockCounter, err := ethutil.NewBlockCounter(pv.client)
@@ -105,6 +119,15 @@ func connectWithClient(
 	}
 	pv.blockCounter = blockCounter
 
+	pv.balanceCache = newCachingBalanceSource(
+		newHealthCheckedBalanceSource(
+			pv.WeiBalanceOf,
+			defaultDegradedRPCThreshold,
+			nil,
+		).balanceOf,
+		defaultBalanceCacheTTL,
+	)
+
 	if pv.accountKey == nil {
 		key, err := ethutil.DecryptKeyFile(
 			config.Account.KeyFile,
@@ -361,10 +384,11 @@ func fetchChainConfig(ec *ethereumChain) (*relaychain.Config, error) {
 	}
 
 	return &relaychain.Config{
-		GroupSize:                  int(groupSize.Int64()),
-		HonestThreshold:            int(threshold.Int64()),
-		TicketSubmissionTimeout:    ticketSubmissionTimeout.Uint64(),
-		ResultPublicationBlockStep: resultPublicationBlockStep.Uint64(),
-		RelayEntryTimeout:          relayEntryTimeout.Uint64(),
+		GroupSize:                   int(groupSize.Int64()),
+		HonestThreshold:             int(threshold.Int64()),
+		TicketSubmissionTimeout:     ticketSubmissionTimeout.Uint64(),
+		ResultPublicationBlockStep:  resultPublicationBlockStep.Uint64(),
+		RelayEntryTimeout:           relayEntryTimeout.Uint64(),
+		RelayEntryConfirmationDepth: RelayEntryConfirmationDepth,
 	}, nil
 }
@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"time"
@@ -319,6 +320,51 @@ func (ec *ethereumChain) GetGroupMembers(groupPublicKey []byte) (
 	return stakerAddresses, nil
 }
 
+func (ec *ethereumChain) IsGroupMemberActive(
+	groupPublicKey []byte,
+	memberIndex relayChain.GroupMemberIndex,
+) (bool, error) {
+	members, err := ec.cachedGroupMembers(groupPublicKey)
+	if err != nil {
+		return false, err
+	}
+
+	if memberIndex < 1 || int(memberIndex) > len(members) {
+		return false, fmt.Errorf(
+			"member index [%v] out of range for group of size [%v]",
+			memberIndex,
+			len(members),
+		)
+	}
+
+	return ec.HasMinimumStake(members[memberIndex-1])
+}
+
+// cachedGroupMembers returns addresses of members of the group with the given
+// public key. Members are fetched from the chain only the first time they are
+// requested for the given group.
+func (ec *ethereumChain) cachedGroupMembers(
+	groupPublicKey []byte,
+) ([]common.Address, error) {
+	ec.groupMembersMutex.Lock()
+	defer ec.groupMembersMutex.Unlock()
+
+	groupKey := hex.EncodeToString(groupPublicKey)
+	if members, ok := ec.groupMembersCache[groupKey]; ok {
+		return members, nil
+	}
+
+	members, err := ec.keepRandomBeaconOperatorContract.GetGroupMembers(
+		groupPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ec.groupMembersCache[groupKey] = members
+	return members, nil
+}
+
 func (ec *ethereumChain) OnDKGResultSubmitted(
 	handler func(dkgResultPublication *event.DKGResultSubmission),
 ) subscription.EventSubscription {
@@ -433,10 +479,14 @@ func (ec *ethereumChain) SubmitDKGResult(
 		return resultPublicationPromise
 	}
 
+	// Submit the result in the same canonical form as used for hashing so
+	// that the hash calculated on-chain matches the signed one.
+	canonicalResult := result.Canonical()
+
 	if _, err = ec.keepRandomBeaconOperatorContract.SubmitDkgResult(
 		big.NewInt(int64(participantIndex)),
-		result.GroupPublicKey,
-		result.Misbehaved,
+		canonicalResult.GroupPublicKey,
+		canonicalResult.Misbehaved,
 		signaturesOnChainFormat,
 		membersIndicesOnChainFormat,
 	); err != nil {
@@ -452,6 +502,8 @@ func (ec *ethereumChain) SubmitDKGResult(
 // slice contains indices of members from the map, second slice is a slice of
 // concatenated signatures. Signatures and member indices are returned in the
 // matching order. It requires each signature to be exactly 65-byte long.
+// Signatures are normalized to have S in the lower half of the curve order so
+// that they are not rejected by the contract as malleable.
 func convertSignaturesToChainFormat(
 	signatures map[relayChain.GroupMemberIndex][]byte,
 ) ([]*big.Int, []byte, error) {
@@ -468,7 +520,10 @@ func convertSignaturesToChainFormat(
 			)
 		}
 		membersIndices = append(membersIndices, big.NewInt(int64(memberIndex)))
-		signaturesSlice = append(signaturesSlice, signature...)
+		signaturesSlice = append(
+			signaturesSlice,
+			normalizeSignature(signature)...,
+		)
 	}
 
 	return membersIndices, signaturesSlice, nil
@@ -484,8 +539,13 @@ func (ec *ethereumChain) CalculateDKGResultHash(
 	dkgResult *relayChain.DKGResult,
 ) (relayChain.DKGResultHash, error) {
 
+	canonicalResult := dkgResult.Canonical()
+
 	// Encode DKG result to the format matched with Solidity keccak256(abi.encodePacked(...))
-	hash := crypto.Keccak256(dkgResult.GroupPublicKey, dkgResult.Misbehaved)
+	hash := crypto.Keccak256(
+		canonicalResult.GroupPublicKey,
+		canonicalResult.Misbehaved,
+	)
 
 	return relayChain.DKGResultHashFromBytes(hash)
 }
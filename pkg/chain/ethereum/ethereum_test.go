@@ -41,6 +41,13 @@ func TestCalculateDKGResultHash(t *testing.T) {
 			},
 			expectedHash: "9b84bec611298ebcd371abd418e5716f511d7ff3f086cc574a84afe01afb02ec",
 		},
+		"dkg result with misbehaving members in non-canonical order": {
+			dkgResult: &relaychain.DKGResult{
+				GroupPublicKey: []byte{0x64},
+				Misbehaved:     []byte{0x05, 0x03},
+			},
+			expectedHash: "9b84bec611298ebcd371abd418e5716f511d7ff3f086cc574a84afe01afb02ec",
+		},
 	}
 
 	for testName, test := range tests {
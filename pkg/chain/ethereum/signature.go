@@ -0,0 +1,59 @@
+package ethereum
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+)
+
+// NormalizeS returns s in the lower half of the order of the given curve.
+// Signatures with s in the upper half of the curve order are malleable and
+// rejected by contracts, so if s is greater than half of the curve order it is
+// replaced with N - s, where N is the curve order. The returned flag is true if
+// s has been replaced; in that case the recovery id of the signature has to be
+// flipped as well for the signature to stay valid.
+func NormalizeS(s *big.Int, curve elliptic.Curve) (*big.Int, bool) {
+	curveOrder := curve.Params().N
+	halfCurveOrder := new(big.Int).Rsh(curveOrder, 1)
+
+	if s.Cmp(halfCurveOrder) <= 0 {
+		return s, false
+	}
+
+	return new(big.Int).Sub(curveOrder, s), true
+}
+
+// normalizeSignature returns the given 65-byte [R || S || V] signature with S
+// in the lower half of the secp256k1 curve order. If S has been normalized,
+// the recovery id V is flipped accordingly, keeping the 0/1 or 27/28 recovery
+// id convention of the signature. The passed signature is not modified.
+func normalizeSignature(signature []byte) []byte {
+	normalized := make([]byte, ethutil.SignatureSize)
+	copy(normalized, signature)
+
+	s := new(big.Int).SetBytes(signature[32:64])
+	normalizedS, flipped := NormalizeS(s, crypto.S256())
+	if !flipped {
+		return normalized
+	}
+
+	normalizedSBytes := normalizedS.Bytes()
+	for i := 32; i < 64; i++ {
+		normalized[i] = 0
+	}
+	copy(normalized[64-len(normalizedSBytes):64], normalizedSBytes)
+	normalized[64] = flipRecoveryID(normalized[64])
+
+	return normalized
+}
+
+// flipRecoveryID flips the recovery id of a signature between 0 and 1, or
+// between 27 and 28 if ethereum convention of adding 27 is used.
+func flipRecoveryID(v byte) byte {
+	if v >= 27 {
+		return 27 + ((v - 27) ^ 1)
+	}
+	return v ^ 1
+}
@@ -0,0 +1,170 @@
+package ethereum
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestNormalizeS(t *testing.T) {
+	curveOrder := crypto.S256().Params().N
+	halfCurveOrder := new(big.Int).Rsh(curveOrder, 1)
+
+	var tests = map[string]struct {
+		s               *big.Int
+		expectedS       *big.Int
+		expectedFlipped bool
+	}{
+		"s in the lower half": {
+			s:               big.NewInt(1410),
+			expectedS:       big.NewInt(1410),
+			expectedFlipped: false,
+		},
+		"s equal to half of the curve order": {
+			s:               halfCurveOrder,
+			expectedS:       halfCurveOrder,
+			expectedFlipped: false,
+		},
+		"s in the upper half": {
+			s:               new(big.Int).Sub(curveOrder, big.NewInt(1410)),
+			expectedS:       big.NewInt(1410),
+			expectedFlipped: true,
+		},
+	}
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			s, flipped := NormalizeS(test.s, crypto.S256())
+
+			if s.Cmp(test.expectedS) != 0 {
+				t.Errorf(
+					"unexpected s\nexpected: %v\nactual:   %v\n",
+					test.expectedS,
+					s,
+				)
+			}
+			if flipped != test.expectedFlipped {
+				t.Errorf(
+					"unexpected flip\nexpected: %v\nactual:   %v\n",
+					test.expectedFlipped,
+					flipped,
+				)
+			}
+		})
+	}
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Signature produced by go-ethereum always has s in the lower half of
+	// the curve order and recovery id 0 or 1. We need signatures with both
+	// recovery ids, so we sign different messages until we have them.
+	lowerHalfSignatures := make(map[byte][]byte)
+	hashes := make(map[byte][]byte)
+	for i := 0; len(lowerHalfSignatures) < 2; i++ {
+		hash := crypto.Keccak256([]byte(fmt.Sprintf("marry had %v lambs", i)))
+		signature, err := crypto.Sign(hash, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := lowerHalfSignatures[signature[64]]; !ok {
+			lowerHalfSignatures[signature[64]] = signature
+			hashes[signature[64]] = hash
+		}
+	}
+
+	// withV returns a copy of the signature with the given recovery id.
+	withV := func(signature []byte, v byte) []byte {
+		result := make([]byte, len(signature))
+		copy(result, signature)
+		result[64] = v
+		return result
+	}
+
+	// malleable returns a copy of the signature with s in the upper half of
+	// the curve order and the given recovery id, which has to be the other
+	// recovery id than the one of the passed signature for the result to be
+	// valid for the same key.
+	malleable := func(signature []byte, v byte) []byte {
+		result := withV(signature, v)
+		upperHalfS := new(big.Int).Sub(
+			crypto.S256().Params().N,
+			new(big.Int).SetBytes(signature[32:64]),
+		)
+		copy(result[32:64], common.LeftPadBytes(upperHalfS.Bytes(), 32))
+		return result
+	}
+
+	var tests = map[string]struct {
+		signature         []byte
+		hash              []byte
+		expectedSignature []byte
+	}{
+		"s in the lower half, v = 27": {
+			signature:         withV(lowerHalfSignatures[0], 27),
+			hash:              hashes[0],
+			expectedSignature: withV(lowerHalfSignatures[0], 27),
+		},
+		"s in the lower half, v = 28": {
+			signature:         withV(lowerHalfSignatures[1], 28),
+			hash:              hashes[1],
+			expectedSignature: withV(lowerHalfSignatures[1], 28),
+		},
+		"s in the upper half, v = 27": {
+			signature:         malleable(lowerHalfSignatures[1], 27),
+			hash:              hashes[1],
+			expectedSignature: withV(lowerHalfSignatures[1], 28),
+		},
+		"s in the upper half, v = 28": {
+			signature:         malleable(lowerHalfSignatures[0], 28),
+			hash:              hashes[0],
+			expectedSignature: withV(lowerHalfSignatures[0], 27),
+		},
+		"s in the upper half, v = 0": {
+			signature:         malleable(lowerHalfSignatures[1], 0),
+			hash:              hashes[1],
+			expectedSignature: withV(lowerHalfSignatures[1], 1),
+		},
+		"s in the upper half, v = 1": {
+			signature:         malleable(lowerHalfSignatures[0], 1),
+			hash:              hashes[0],
+			expectedSignature: withV(lowerHalfSignatures[0], 0),
+		},
+	}
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			normalized := normalizeSignature(test.signature)
+
+			if !bytes.Equal(test.expectedSignature, normalized) {
+				t.Errorf(
+					"unexpected signature\nexpected: %x\nactual:   %x\n",
+					test.expectedSignature,
+					normalized,
+				)
+			}
+
+			recoverySignature := withV(normalized, normalized[64])
+			if recoverySignature[64] >= 27 {
+				recoverySignature[64] -= 27
+			}
+
+			publicKey, err := crypto.SigToPub(test.hash, recoverySignature)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(
+				crypto.FromECDSAPub(&privateKey.PublicKey),
+				crypto.FromECDSAPub(publicKey),
+			) {
+				t.Errorf("normalized signature recovers unexpected public key")
+			}
+		})
+	}
+}
@@ -34,13 +34,15 @@ func (lbc *localBlockCounter) WaitForBlockHeight(blockNumber uint64) error {
 func (lbc *localBlockCounter) BlockHeightWaiter(
 	blockNumber uint64,
 ) (<-chan uint64, error) {
-	newWaiter := make(chan uint64)
+	// Waiter channel is buffered so that the block height can be delivered
+	// without blocking, in the order in which blocks are counted.
+	newWaiter := make(chan uint64, 1)
 
 	lbc.structMutex.Lock()
 	defer lbc.structMutex.Unlock()
 
 	if blockNumber <= lbc.blockHeight {
-		go func() { newWaiter <- blockNumber }()
+		newWaiter <- blockNumber
 	} else {
 		waiterList, exists := lbc.waiters[blockNumber]
 		if !exists {
@@ -92,34 +94,40 @@ func (lbc *localBlockCounter) count() {
 	ticker := time.NewTicker(blockTime)
 
 	for range ticker.C {
-		lbc.structMutex.Lock()
-		lbc.blockHeight++
-		height := lbc.blockHeight
-		waiters, exists := lbc.waiters[height]
-		delete(lbc.waiters, height)
-		lbc.structMutex.Unlock()
+		lbc.advance()
+	}
+}
 
-		if exists {
-			for _, waiter := range waiters {
-				go func(w chan uint64) { w <- height }(waiter)
-			}
+// advance increases the block height by one and notifies all waiters and
+// watchers interested in the new block.
+func (lbc *localBlockCounter) advance() {
+	lbc.structMutex.Lock()
+	lbc.blockHeight++
+	height := lbc.blockHeight
+	waiters, exists := lbc.waiters[height]
+	delete(lbc.waiters, height)
+	lbc.structMutex.Unlock()
+
+	if exists {
+		for _, waiter := range waiters {
+			waiter <- height
 		}
+	}
 
-		lbc.structMutex.Lock()
-		watchers := make([]*watcher, len(lbc.watchers))
-		copy(watchers, lbc.watchers)
-		lbc.structMutex.Unlock()
+	lbc.structMutex.Lock()
+	watchers := make([]*watcher, len(lbc.watchers))
+	copy(watchers, lbc.watchers)
+	lbc.structMutex.Unlock()
 
-		for _, watcher := range watchers {
-			if watcher.ctx.Err() != nil {
-				close(watcher.channel)
-				continue
-			}
+	for _, watcher := range watchers {
+		if watcher.ctx.Err() != nil {
+			close(watcher.channel)
+			continue
+		}
 
-			select {
-			case watcher.channel <- height: // perfect
-			default: // we don't care, let's drop it
-			}
+		select {
+		case watcher.channel <- height: // perfect
+		default: // we don't care, let's drop it
 		}
 	}
 }
@@ -134,3 +142,37 @@ func BlockCounter() (chain.BlockCounter, error) {
 
 	return &counter, nil
 }
+
+// SimulatedBlockCounter is a BlockCounter running completely locally whose
+// block height does not increase with time but is advanced explicitly with
+// AdvanceBlocks. It is designed for deterministic tests of code waiting for
+// blocks.
+type SimulatedBlockCounter struct {
+	*localBlockCounter
+
+	advanceMutex sync.Mutex
+}
+
+// NewSimulatedBlockCounter creates a SimulatedBlockCounter with block height
+// set to zero.
+func NewSimulatedBlockCounter() *SimulatedBlockCounter {
+	return &SimulatedBlockCounter{
+		localBlockCounter: &localBlockCounter{
+			blockHeight: 0,
+			waiters:     make(map[uint64][]chan uint64),
+		},
+	}
+}
+
+// AdvanceBlocks increases the block height by the given number of blocks,
+// one block at a time. Waiters for each block are released before the block
+// height is increased further. It is safe to call AdvanceBlocks concurrently
+// from multiple goroutines.
+func (sbc *SimulatedBlockCounter) AdvanceBlocks(blocks int) {
+	sbc.advanceMutex.Lock()
+	defer sbc.advanceMutex.Unlock()
+
+	for i := 0; i < blocks; i++ {
+		sbc.advance()
+	}
+}
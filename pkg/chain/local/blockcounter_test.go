@@ -0,0 +1,139 @@
+package local
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+var _ chain.BlockCounter = NewSimulatedBlockCounter()
+
+func TestSimulatedBlockCounterReleasesWaitersInOrder(t *testing.T) {
+	blockCounter := NewSimulatedBlockCounter()
+
+	waitHeights := []uint64{5, 2, 8, 3}
+
+	releasedMutex := sync.Mutex{}
+	released := make([]uint64, 0)
+	releasedChannel := make(chan uint64)
+
+	for _, height := range waitHeights {
+		go func(height uint64) {
+			err := blockCounter.WaitForBlockHeight(height)
+			if err != nil {
+				t.Error(err)
+			}
+
+			releasedMutex.Lock()
+			released = append(released, height)
+			releasedMutex.Unlock()
+
+			releasedChannel <- height
+		}(height)
+	}
+
+	// Give waiters a chance to register before the height is advanced.
+	time.Sleep(100 * time.Millisecond)
+
+	releasedCount := 0
+	assertReleased := func(expected []uint64) {
+		for ; releasedCount < len(expected); releasedCount++ {
+			select {
+			case <-releasedChannel:
+			case <-time.After(time.Second):
+				t.Fatalf("waiter not released on time")
+			}
+		}
+
+		// Make sure no other waiter has been released.
+		select {
+		case height := <-releasedChannel:
+			t.Fatalf("unexpected waiter for block [%v] released", height)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		releasedMutex.Lock()
+		defer releasedMutex.Unlock()
+
+		actual := make([]uint64, len(released))
+		copy(actual, released)
+		sort.Slice(actual, func(i, j int) bool { return actual[i] < actual[j] })
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf(
+				"unexpected released waiters\nexpected: %v\nactual:   %v\n",
+				expected,
+				actual,
+			)
+		}
+	}
+
+	assertReleased([]uint64{})
+
+	blockCounter.AdvanceBlocks(3)
+	assertReleased([]uint64{2, 3})
+
+	blockCounter.AdvanceBlocks(2)
+	assertReleased([]uint64{2, 3, 5})
+
+	blockCounter.AdvanceBlocks(10)
+	assertReleased([]uint64{2, 3, 5, 8})
+}
+
+func TestSimulatedBlockCounterWaiterForPastBlock(t *testing.T) {
+	blockCounter := NewSimulatedBlockCounter()
+	blockCounter.AdvanceBlocks(4)
+
+	waiter, err := blockCounter.BlockHeightWaiter(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case height := <-waiter:
+		if height != 2 {
+			t.Errorf(
+				"unexpected block height\nexpected: %v\nactual:   %v\n",
+				2,
+				height,
+			)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("waiter for a past block not released")
+	}
+}
+
+func TestSimulatedBlockCounterConcurrentAdvance(t *testing.T) {
+	blockCounter := NewSimulatedBlockCounter()
+
+	goroutines := 10
+	blocksPerGoroutine := 5
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			blockCounter.AdvanceBlocks(blocksPerGoroutine)
+		}()
+	}
+	wg.Wait()
+
+	currentBlock, err := blockCounter.CurrentBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedBlock := uint64(goroutines * blocksPerGoroutine)
+	if currentBlock != expectedBlock {
+		t.Errorf(
+			"unexpected current block\nexpected: %v\nactual:   %v\n",
+			expectedBlock,
+			currentBlock,
+		)
+	}
+}
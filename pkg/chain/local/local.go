@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -47,6 +48,10 @@ type Chain interface {
 	// GetRelayEntryTimeoutReports returns an array of blocks which denote at what
 	// block a relay entry timeout occured.
 	GetRelayEntryTimeoutReports() []uint64
+
+	// SlashGroupMember marks the member with the given index in the group with
+	// the given public key as slashed so that it is no longer active.
+	SlashGroupMember(groupPublicKey []byte, memberIndex relaychain.GroupMemberIndex)
 }
 
 type localGroup struct {
@@ -80,6 +85,9 @@ type localChain struct {
 	relayEntryTimeoutReportsMutex sync.Mutex
 	relayEntryTimeoutReports      []uint64
 
+	slashedGroupMembersMutex sync.Mutex
+	slashedGroupMembers      map[string]map[relaychain.GroupMemberIndex]bool
+
 	operatorKey *ecdsa.PrivateKey
 
 	minimumStake *big.Int
@@ -336,17 +344,34 @@ func ConnectWithKey(
 		stakeMonitor:             NewStakeMonitor(minimumStake),
 		tickets:                  make([]*relaychain.Ticket, 0),
 		groups:                   []localGroup{group},
+		slashedGroupMembers:      make(map[string]map[relaychain.GroupMemberIndex]bool),
 		operatorKey:              operatorKey,
 		minimumStake:             minimumStake,
 	}
 }
 
-func selectGroup(entry *big.Int, numberOfGroups int) int {
+// selectGroup returns the index of the group which should produce the next
+// relay entry, given the previous relay entry and the number of groups.
+// The previous entry is interpreted as a big-endian unsigned integer and the
+// index is the value of that integer modulo the number of groups. Given
+// a uniformly distributed entry, the selection is uniform over all groups,
+// up to the negligible modulo bias of a 256-bit value.
+//
+// An error is returned if the previous entry is empty. Such an entry would be
+// interpreted as zero and the first group would be silently selected for
+// every malformed request.
+func selectGroup(previousEntry []byte, numberOfGroups int) (int, error) {
+	if len(previousEntry) == 0 {
+		return 0, fmt.Errorf("previous entry is empty")
+	}
+
 	if numberOfGroups == 0 {
-		return 0
+		return 0, nil
 	}
 
-	return int(new(big.Int).Mod(entry, big.NewInt(int64(numberOfGroups))).Int64())
+	entry := new(big.Int).SetBytes(previousEntry)
+
+	return int(new(big.Int).Mod(entry, big.NewInt(int64(numberOfGroups))).Int64()), nil
 }
 
 func (c *localChain) IsStaleGroup(groupPublicKey []byte) (bool, error) {
@@ -382,6 +407,31 @@ func (c *localChain) GetGroupMembers(groupPublicKey []byte) (
 	return nil, nil // no-op
 }
 
+func (c *localChain) IsGroupMemberActive(
+	groupPublicKey []byte,
+	memberIndex relaychain.GroupMemberIndex,
+) (bool, error) {
+	c.slashedGroupMembersMutex.Lock()
+	defer c.slashedGroupMembersMutex.Unlock()
+
+	slashed := c.slashedGroupMembers[hex.EncodeToString(groupPublicKey)]
+	return !slashed[memberIndex], nil
+}
+
+func (c *localChain) SlashGroupMember(
+	groupPublicKey []byte,
+	memberIndex relaychain.GroupMemberIndex,
+) {
+	c.slashedGroupMembersMutex.Lock()
+	defer c.slashedGroupMembersMutex.Unlock()
+
+	groupKey := hex.EncodeToString(groupPublicKey)
+	if c.slashedGroupMembers[groupKey] == nil {
+		c.slashedGroupMembers[groupKey] = make(map[relaychain.GroupMemberIndex]bool)
+	}
+	c.slashedGroupMembers[groupKey][memberIndex] = true
+}
+
 func (c *localChain) IsGroupRegistered(groupPublicKey []byte) (bool, error) {
 	for _, group := range c.groups {
 		if bytes.Compare(group.groupPublicKey, groupPublicKey) == 0 {
@@ -515,8 +565,19 @@ func (c *localChain) CurrentRequestPreviousEntry() ([]byte, error) {
 	panic("not implemented")
 }
 
+// CurrentRequestGroupPublicKey returns the public key of the group selected
+// to produce a relay entry from the last submitted one.
 func (c *localChain) CurrentRequestGroupPublicKey() ([]byte, error) {
-	panic("not implemented")
+	if len(c.groups) == 0 {
+		return nil, fmt.Errorf("there are no registered groups")
+	}
+
+	index, err := selectGroup(c.lastSubmittedRelayEntry, len(c.groups))
+	if err != nil {
+		return nil, fmt.Errorf("could not select group: [%v]", err)
+	}
+
+	return c.groups[index].groupPublicKey, nil
 }
 
 func (c *localChain) GetRelayEntryTimeoutReports() []uint64 {
@@ -531,7 +592,7 @@ func (c *localChain) MinimumStake() (*big.Int, error) {
 func (c *localChain) CalculateDKGResultHash(
 	dkgResult *relaychain.DKGResult,
 ) (relaychain.DKGResultHash, error) {
-	encodedDKGResult := fmt.Sprint(dkgResult)
+	encodedDKGResult := fmt.Sprint(dkgResult.Canonical())
 	dkgResultHash := relaychain.DKGResultHash(
 		sha3.Sum256([]byte(encodedDKGResult)),
 	)
@@ -1,6 +1,7 @@
 package local
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -134,6 +135,38 @@ func TestLocalSubmitRelayEntry(t *testing.T) {
 
 }
 
+func TestLocalCurrentRequestGroupPublicKey(t *testing.T) {
+	chainHandle := Connect(10, 4, big.NewInt(200)).ThresholdRelay()
+
+	_, err := chainHandle.CurrentRequestGroupPublicKey()
+	expectedError := fmt.Errorf(
+		"could not select group: [previous entry is empty]",
+	)
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Fatalf(
+			"Unexpected error\nexpected: [%v]\nactual:   [%v]\n",
+			expectedError,
+			err,
+		)
+	}
+
+	chainHandle.SubmitRelayEntry(big.NewInt(19).Bytes())
+
+	groupPublicKey, err := chainHandle.CurrentRequestGroupPublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// there is only the seed group registered
+	if !bytes.Equal(seedGroupPublicKey, groupPublicKey) {
+		t.Fatalf(
+			"Unexpected group public key\nexpected: [%x]\nactual:   [%x]\n",
+			seedGroupPublicKey,
+			groupPublicKey,
+		)
+	}
+}
+
 func TestLocalOnEntrySubmitted(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
@@ -698,28 +731,44 @@ func TestLocalSubmitDKGResultWithSignatures(t *testing.T) {
 func TestCalculateDKGResultHash(t *testing.T) {
 	localChain := &localChain{}
 
-	dkgResult := &relaychain.DKGResult{
-		GroupPublicKey: []byte{3, 40, 200},
-		Misbehaved:     []byte{1, 2, 8, 14},
-	}
 	expectedHashString := "97a94a3b11a0f780c9510df852ac7f77072085d5bda4b07e5d198396dd4f68e5"
 
-	actualHash, err := localChain.CalculateDKGResultHash(dkgResult)
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	expectedHash := relaychain.DKGResultHash{}
 	copy(
 		expectedHash[:],
 		common.Hex2Bytes(expectedHashString)[:32],
 	)
 
-	if expectedHash != actualHash {
-		t.Fatalf("\nexpected: %x\nactual:   %x\n",
-			expectedHash,
-			actualHash,
-		)
+	var tests = map[string]struct {
+		misbehaved []byte
+	}{
+		"misbehaved members in canonical order": {
+			misbehaved: []byte{1, 2, 8, 14},
+		},
+		"misbehaved members in non-canonical order": {
+			misbehaved: []byte{8, 1, 14, 2},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dkgResult := &relaychain.DKGResult{
+				GroupPublicKey: []byte{3, 40, 200},
+				Misbehaved:     test.misbehaved,
+			}
+
+			actualHash, err := localChain.CalculateDKGResultHash(dkgResult)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if expectedHash != actualHash {
+				t.Fatalf("\nexpected: %x\nactual:   %x\n",
+					expectedHash,
+					actualHash,
+				)
+			}
+		})
 	}
 }
 
@@ -732,40 +781,80 @@ func newTestContext(timeout ...time.Duration) (context.Context, context.CancelFu
 }
 
 func TestNextGroupIndex(t *testing.T) {
+	fullEntry := make([]byte, 32)
+	fullEntry[0] = 0xff
+	fullEntry[31] = 0x0e
+
 	var tests = map[string]struct {
-		previousEntry  int
+		previousEntry  []byte
 		numberOfGroups int
 		expectedIndex  int
+		expectedError  error
 	}{
 		"zero groups": {
-			previousEntry:  12,
+			previousEntry:  big.NewInt(12).Bytes(),
 			numberOfGroups: 0,
 			expectedIndex:  0,
 		},
 		"fewer groups than the previous entry value": {
-			previousEntry:  13,
+			previousEntry:  big.NewInt(13).Bytes(),
 			numberOfGroups: 4,
 			expectedIndex:  1,
 		},
 		"more groups than the previous entry value": {
-			previousEntry:  3,
+			previousEntry:  big.NewInt(3).Bytes(),
 			numberOfGroups: 12,
 			expectedIndex:  3,
 		},
+		"empty previous entry": {
+			previousEntry:  []byte{},
+			numberOfGroups: 4,
+			expectedError:  fmt.Errorf("previous entry is empty"),
+		},
+		"nil previous entry": {
+			previousEntry:  nil,
+			numberOfGroups: 4,
+			expectedError:  fmt.Errorf("previous entry is empty"),
+		},
+		"single-byte previous entry": {
+			previousEntry:  []byte{0xfe},
+			numberOfGroups: 5,
+			expectedIndex:  4, // 254 mod 5
+		},
+		"full 32-byte previous entry": {
+			previousEntry:  fullEntry,
+			numberOfGroups: 7,
+			expectedIndex: int(new(big.Int).Mod(
+				new(big.Int).SetBytes(fullEntry),
+				big.NewInt(7),
+			).Int64()),
+		},
+		"full 32-byte previous entry with a power of two groups": {
+			previousEntry:  fullEntry,
+			numberOfGroups: 16,
+			expectedIndex:  14, // the lowest 4 bits of the entry
+		},
 	}
 
 	for nextGroupIndexTest, test := range tests {
 		t.Run(nextGroupIndexTest, func(t *testing.T) {
-			bigPreviousEntry := big.NewInt(int64(test.previousEntry))
-			bigNumberOfGroups := test.numberOfGroups
-			expectedIndex := test.expectedIndex
+			actualIndex, err := selectGroup(
+				test.previousEntry,
+				test.numberOfGroups,
+			)
 
-			actualIndex := selectGroup(bigPreviousEntry, bigNumberOfGroups)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Fatalf(
+					"Unexpected error\nexpected: [%v]\nactual:   [%v]\n",
+					test.expectedError,
+					err,
+				)
+			}
 
-			if actualIndex != expectedIndex {
+			if actualIndex != test.expectedIndex {
 				t.Fatalf(
 					"Unexpected group index selected\nexpected: [%v]\nactual:   [%v]\n",
-					expectedIndex,
+					test.expectedIndex,
 					actualIndex,
 				)
 			}
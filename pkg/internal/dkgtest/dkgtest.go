@@ -12,12 +12,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
 	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
 	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
 	"github.com/keep-network/keep-core/pkg/internal/interception"
 	"github.com/keep-network/keep-core/pkg/net/key"
@@ -63,12 +65,35 @@ func RunTest(
 	seed *big.Int,
 	rules interception.Rules,
 ) (*Result, error) {
-	privateKey, publicKey, err := operator.GenerateKeyPair()
+	privateKey, _, err := operator.GenerateKeyPair()
 	if err != nil {
 		return nil, err
 	}
 
-	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+	return RunTestWithOperatorKey(
+		groupSize,
+		honestThreshold,
+		seed,
+		rules,
+		privateKey,
+	)
+}
+
+// RunTestWithOperatorKey executes the full DKG roundtrip test just like
+// RunTest does but all group members use the provided operator key. Knowing
+// the key, interception rules can sign messages on behalf of a misbehaving
+// member, for example accusations altered by the rules.
+func RunTestWithOperatorKey(
+	groupSize int,
+	honestThreshold int,
+	seed *big.Int,
+	rules interception.Rules,
+	privateKey *operator.PrivateKey,
+) (*Result, error) {
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(
+		privateKey,
+		&privateKey.PublicKey,
+	)
 
 	network := interception.NewNetwork(
 		netLocal.ConnectWithKey(networkPublicKey),
@@ -91,7 +116,36 @@ func RunTest(
 		selectedStakers[i] = address
 	}
 
-	return executeDKG(seed, chain, network, selectedStakers)
+	staker, err := stakeOperator(chain, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return executeDKG(seed, chain, network, selectedStakers, staker)
+}
+
+// stakeOperator stakes enough tokens for the operator with the given address
+// to take part in DKG and returns its staker.
+func stakeOperator(
+	localChain chainLocal.Chain,
+	address []byte,
+) (chain.Staker, error) {
+	stakeMonitor, err := localChain.StakeMonitor()
+	if err != nil {
+		return nil, err
+	}
+
+	localStakeMonitor, ok := stakeMonitor.(*chainLocal.StakeMonitor)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of stake monitor")
+	}
+
+	stakerAddress := common.BytesToAddress(address).Hex()
+	if err := localStakeMonitor.StakeTokens(stakerAddress); err != nil {
+		return nil, err
+	}
+
+	return localStakeMonitor.StakerFor(stakerAddress)
 }
 
 func executeDKG(
@@ -99,6 +153,7 @@ func executeDKG(
 	chain chainLocal.Chain,
 	network interception.Network,
 	selectedStakers []relaychain.StakerAddress,
+	staker chain.Staker,
 ) (*Result, error) {
 	relayConfig := chain.ThresholdRelay().GetConfig()
 
@@ -152,11 +207,13 @@ func executeDKG(
 				uint8(i),
 				relayConfig.GroupSize,
 				relayConfig.DishonestThreshold(),
+				relayConfig.MaxAccusations,
 				membershipValidator,
 				startBlockHeight,
 				blockCounter,
 				chain.ThresholdRelay(),
 				chain.Signing(),
+				staker,
 				broadcastChannel,
 			)
 			if signer != nil {
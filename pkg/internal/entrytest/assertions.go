@@ -1,6 +1,10 @@
 package entrytest
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/entry"
+)
 
 // AssertEntryPublished checks if relay entry has been published to the chain.
 // It does not inspect the entry.
@@ -50,3 +54,28 @@ func AssertSignerFailuresCount(
 		)
 	}
 }
+
+// AssertSignerFailuresClassified checks the number of signers whose failure
+// has been classified into the given category.
+func AssertSignerFailuresClassified(
+	t *testing.T,
+	testResult *Result,
+	failure entry.Failure,
+	expectedCount int,
+) {
+	count := 0
+	for _, err := range testResult.signerFailures {
+		if entry.ClassifyFailure(err) == failure {
+			count++
+		}
+	}
+
+	if count != expectedCount {
+		t.Errorf(
+			"unexpected number of [%v] signer failures\nexpected: [%v]\nactual:   [%v]",
+			failure,
+			expectedCount,
+			count,
+		)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/entry"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 
 	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
 	netLocal "github.com/keep-network/keep-core/pkg/net/local"
@@ -77,7 +78,75 @@ func RunTest(
 
 	chain := chainLocal.ConnectWithKey(len(signers), threshold, minimumStake, privateKey)
 
-	return executeSigning(signers, threshold, chain, network, previousEntry)
+	return executeSigning(signers, threshold, chain, network, previousEntry, nil)
+}
+
+// RunTestWithSlashedMembers executes the full relay entry signing roundtrip
+// test just like RunTest but before the signing starts, it marks group members
+// with the provided indexes as slashed on-chain.
+func RunTestWithSlashedMembers(
+	signers []*dkg.ThresholdSigner,
+	threshold int,
+	rules interception.Rules,
+	previousEntry []byte,
+	slashedMembers []group.MemberIndex,
+) (*Result, error) {
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("no signers provided")
+	}
+
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	network := interception.NewNetwork(
+		netLocal.ConnectWithKey(networkPublicKey),
+		rules,
+	)
+
+	chain := chainLocal.ConnectWithKey(len(signers), threshold, minimumStake, privateKey)
+	for _, memberID := range slashedMembers {
+		chain.SlashGroupMember(signers[0].GroupPublicKeyBytes(), memberID)
+	}
+
+	return executeSigning(signers, threshold, chain, network, previousEntry, nil)
+}
+
+// RunTestWithGroupPublicKey executes the full relay entry signing roundtrip
+// test just like RunTest but signers verify the produced signature against
+// the provided group public key instead of the public key of their group.
+func RunTestWithGroupPublicKey(
+	signers []*dkg.ThresholdSigner,
+	threshold int,
+	rules interception.Rules,
+	previousEntry []byte,
+	groupPublicKey []byte,
+) (*Result, error) {
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	network := interception.NewNetwork(
+		netLocal.ConnectWithKey(networkPublicKey),
+		rules,
+	)
+
+	chain := chainLocal.ConnectWithKey(len(signers), threshold, minimumStake, privateKey)
+
+	return executeSigning(
+		signers,
+		threshold,
+		chain,
+		network,
+		previousEntry,
+		groupPublicKey,
+	)
 }
 
 func executeSigning(
@@ -86,6 +155,7 @@ func executeSigning(
 	chain chainLocal.Chain,
 	network interception.Network,
 	previousEntry []byte,
+	groupPublicKey []byte,
 ) (*Result, error) {
 	blockCounter, err := chain.BlockCounter()
 	if err != nil {
@@ -132,14 +202,35 @@ func executeSigning(
 
 	for _, signer := range signers {
 		go func(signer *dkg.ThresholdSigner) {
-			err := entry.SignAndSubmit(
+			signerGroupPublicKey := groupPublicKey
+			if signerGroupPublicKey == nil {
+				signerGroupPublicKey = signer.GroupPublicKeyBytes()
+			}
+
+			activeMembers, err := entry.ActiveGroupMembers(
+				chain.ThresholdRelay(),
+				signer,
+			)
+			if err != nil {
+				signerFailuresMutex.Lock()
+				signerFailures = append(signerFailures, err)
+				signerFailuresMutex.Unlock()
+				wg.Done()
+				return
+			}
+
+			err = entry.SignAndSubmit(
 				blockCounter,
 				broadcastChannel,
 				chain.ThresholdRelay(),
+				signerGroupPublicKey,
 				previousEntry,
+				entry.DefaultHashToSignInput(previousEntry),
 				threshold,
 				signer,
+				activeMembers,
 				startBlockHeight,
+				nil,
 			)
 			if err != nil {
 				fmt.Printf("[signer:%v %v] failed with: [%v]\n", signer.MemberID(), previousEntry, err)
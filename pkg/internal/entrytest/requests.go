@@ -0,0 +1,52 @@
+package entrytest
+
+import (
+	"math/big"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+// SeedProvider produces a sequence of relay requests for local simulations
+// of the random beacon, so that the request, sign, submit and new request
+// loop can be exercised without a real chain. Each request signs the entry
+// produced for the previous request, just like in the real random beacon.
+type SeedProvider struct {
+	chain           chainLocal.Chain
+	groupPublicKeys [][]byte
+}
+
+// NewSeedProvider creates a seed provider producing requests for groups with
+// the given public keys. Block numbers of requests are read from the given
+// local chain.
+func NewSeedProvider(
+	chain chainLocal.Chain,
+	groupPublicKeys [][]byte,
+) *SeedProvider {
+	return &SeedProvider{chain, groupPublicKeys}
+}
+
+// NextRequest returns a relay request chained off the given last relay entry.
+// The group producing the new entry is selected as the last entry modulo the
+// number of groups, which is how the selection is done on-chain.
+func (sp *SeedProvider) NextRequest(lastEntry []byte) event.Request {
+	request := event.Request{
+		PreviousEntry: lastEntry,
+	}
+
+	if len(sp.groupPublicKeys) > 0 {
+		selectedGroup := new(big.Int).Mod(
+			new(big.Int).SetBytes(lastEntry),
+			big.NewInt(int64(len(sp.groupPublicKeys))),
+		)
+		request.GroupPublicKey = sp.groupPublicKeys[selectedGroup.Int64()]
+	}
+
+	blockCounter, err := sp.chain.BlockCounter()
+	if err == nil {
+		// Local block counter does not fail reading the current block.
+		request.BlockNumber, _ = blockCounter.CurrentBlock()
+	}
+
+	return request
+}
@@ -0,0 +1,175 @@
+package entrytest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/bls"
+	"github.com/keep-network/keep-core/pkg/internal/interception"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/operator"
+
+	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+)
+
+func TestSeedProviderRequestLoop(t *testing.T) {
+	groupSize := 5
+	threshold := 3
+	iterations := 3
+
+	signers, groupPublicKey, err := generateSigners(groupSize, threshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, publicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, networkPublicKey := key.OperatorKeyToNetworkKey(privateKey, publicKey)
+
+	network := interception.NewNetwork(
+		netLocal.ConnectWithKey(networkPublicKey),
+		func(msg net.TaggedMarshaler) net.TaggedMarshaler { return msg },
+	)
+	chain := chainLocal.ConnectWithKey(groupSize, threshold, minimumStake, privateKey)
+
+	provider := NewSeedProvider(
+		chain,
+		[][]byte{signers[0].GroupPublicKeyBytes()},
+	)
+
+	lastEntry := new(bn256.G1).ScalarBaseMult(big.NewInt(1337)).Marshal()
+	for i := 0; i < iterations; i++ {
+		request := provider.NextRequest(lastEntry)
+
+		if !bytes.Equal(request.PreviousEntry, lastEntry) {
+			t.Fatalf(
+				"iteration [%v]: request does not chain off the last entry",
+				i,
+			)
+		}
+
+		result, err := executeSigning(
+			signers,
+			threshold,
+			chain,
+			network,
+			request.PreviousEntry,
+			request.GroupPublicKey,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.signerFailures) > 0 {
+			t.Fatalf(
+				"iteration [%v]: unexpected signer failures %v",
+				i,
+				result.signerFailures,
+			)
+		}
+
+		newEntry, err := result.EntryValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if newEntry == nil {
+			t.Fatalf("iteration [%v]: no entry submitted", i)
+		}
+
+		previousEntry := new(bn256.G1)
+		if _, err := previousEntry.Unmarshal(request.PreviousEntry); err != nil {
+			t.Fatal(err)
+		}
+		if !bls.VerifyG1(groupPublicKey, previousEntry, newEntry) {
+			t.Fatalf("iteration [%v]: entry is not a signature of the previous entry", i)
+		}
+
+		lastEntry = chain.GetLastRelayEntry()
+	}
+}
+
+func TestSeedProviderSelectsGroup(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, minimumStake)
+
+	groupPublicKeys := [][]byte{{0x01}, {0x02}, {0x03}}
+	provider := NewSeedProvider(chain, groupPublicKeys)
+
+	var tests = map[string]struct {
+		lastEntry              []byte
+		expectedGroupPublicKey []byte
+	}{
+		"first group": {
+			lastEntry:              []byte{0x03},
+			expectedGroupPublicKey: []byte{0x01},
+		},
+		"second group": {
+			lastEntry:              []byte{0x01, 0x00}, // 256 mod 3 = 1
+			expectedGroupPublicKey: []byte{0x02},
+		},
+		"third group": {
+			lastEntry:              []byte{0x05},
+			expectedGroupPublicKey: []byte{0x03},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			request := provider.NextRequest(test.lastEntry)
+
+			if !bytes.Equal(test.expectedGroupPublicKey, request.GroupPublicKey) {
+				t.Errorf(
+					"unexpected group public key\nexpected: %x\nactual:   %x",
+					test.expectedGroupPublicKey,
+					request.GroupPublicKey,
+				)
+			}
+		})
+	}
+}
+
+// generateSigners creates threshold signers of a group with the given size
+// sharing a random group private key.
+func generateSigners(groupSize, threshold int) (
+	[]*dkg.ThresholdSigner,
+	*bn256.G2,
+	error,
+) {
+	masterSecretKey := make([]*big.Int, threshold)
+	for i := range masterSecretKey {
+		coefficient, err := rand.Int(rand.Reader, bn256.Order)
+		if err != nil {
+			return nil, nil, err
+		}
+		masterSecretKey[i] = coefficient
+	}
+
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(masterSecretKey[0])
+
+	secretKeyShares := make([]*bls.SecretKeyShare, groupSize)
+	groupPublicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+	for i := range secretKeyShares {
+		secretKeyShares[i] = bls.GetSecretKeyShare(masterSecretKey, i+1)
+		groupPublicKeyShares[group.MemberIndex(i+1)] =
+			secretKeyShares[i].PublicKeyShare().V
+	}
+
+	signers := make([]*dkg.ThresholdSigner, groupSize)
+	for i, secretKeyShare := range secretKeyShares {
+		signers[i] = dkg.NewThresholdSigner(
+			group.MemberIndex(i+1),
+			groupPublicKey,
+			secretKeyShare.V,
+			groupPublicKeyShares,
+		)
+	}
+
+	return signers, groupPublicKey, nil
+}
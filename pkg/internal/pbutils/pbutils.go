@@ -5,6 +5,7 @@ package pbutils
 import (
 	"crypto/ecdsa"
 	"math/big"
+	"math/rand"
 
 	"github.com/btcsuite/btcd/btcec"
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
@@ -49,6 +50,49 @@ func FuzzUnmarshaler(unmarshaler proto.Unmarshaler) {
 	}
 }
 
+// FuzzUnmarshalerWithSeeds tests given unmarshaler with random mutations of
+// the provided seeds. Seeds are expected to be valid marshaled messages so
+// that, unlike entirely random bytes, mutated bytes get past the protobuf
+// decoding and exercise validation of the decoded message fields.
+func FuzzUnmarshalerWithSeeds(unmarshaler proto.Unmarshaler, seeds ...[]byte) {
+	for _, seed := range seeds {
+		for i := 0; i < 100; i++ {
+			messageBytes := make([]byte, len(seed))
+			copy(messageBytes, seed)
+
+			mutationsCount := 1 + rand.Intn(4)
+			for j := 0; j < mutationsCount; j++ {
+				messageBytes = mutate(messageBytes)
+			}
+
+			_ = unmarshaler.Unmarshal(messageBytes)
+		}
+	}
+}
+
+// mutate flips a random byte, truncates or inserts a random byte into the
+// provided bytes.
+func mutate(bytes []byte) []byte {
+	if len(bytes) == 0 {
+		return []byte{byte(rand.Intn(256))}
+	}
+
+	position := rand.Intn(len(bytes))
+
+	switch rand.Intn(3) {
+	case 0:
+		bytes[position] ^= byte(1 + rand.Intn(255))
+		return bytes
+	case 1:
+		return bytes[:position]
+	default:
+		mutated := make([]byte, 0, len(bytes)+1)
+		mutated = append(mutated, bytes[:position]...)
+		mutated = append(mutated, byte(rand.Intn(256)))
+		return append(mutated, bytes[position:]...)
+	}
+}
+
 // FuzzFuncs returns custom fuzzing functions set.
 func FuzzFuncs() []interface{} {
 	return []interface{}{
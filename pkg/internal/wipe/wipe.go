@@ -0,0 +1,25 @@
+// Package wipe provides helper utilities for overwriting secret values with
+// zeros once they are no longer needed.
+//
+// Wiping is best-effort only. Go runtime may have copied the values while
+// growing slices, moving goroutine stacks or executing arithmetic operations
+// and such copies can not be reached and cleared.
+package wipe
+
+import (
+	"math/big"
+)
+
+// Int overwrites words of the given integer with zeros and sets it to zero.
+// It is a no-op for a nil integer.
+func Int(value *big.Int) {
+	if value == nil {
+		return
+	}
+
+	words := value.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	value.SetInt64(0)
+}
@@ -1,6 +1,9 @@
 package ephemeral
 
-import "testing"
+import (
+	"crypto/rand"
+	"testing"
+)
 
 func TestFullEcdh(t *testing.T) {
 	//
@@ -8,13 +11,13 @@ func TestFullEcdh(t *testing.T) {
 	//
 
 	// player 1
-	keyPair1, err := GenerateKeyPair()
+	keyPair1, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// player 2
-	keyPair2, err := GenerateKeyPair()
+	keyPair2, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2,6 +2,8 @@ package ephemeral
 
 import (
 	"fmt"
+	"io"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcec"
 )
@@ -25,8 +27,13 @@ func curve() *btcec.KoblitzCurve {
 
 // GenerateKeyPair generates a pair of public and private elliptic curve
 // ephemeral key that can be used as an input for ECDH.
-func GenerateKeyPair() (*KeyPair, error) {
-	ecdsaKey, err := btcec.NewPrivateKey(curve())
+//
+// The private key is derived from bytes read from the provided source of
+// randomness. Production code should always pass `crypto/rand.Reader`.
+// A seeded pseudorandom source yields the same key pair for the same seed
+// which makes it possible to replay a protocol execution deterministically.
+func GenerateKeyPair(rand io.Reader) (*KeyPair, error) {
+	scalar, err := randomScalar(rand)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"could not generate new ephemeral keypair: [%v]",
@@ -34,12 +41,34 @@ func GenerateKeyPair() (*KeyPair, error) {
 		)
 	}
 
+	ecdsaKey, _ := btcec.PrivKeyFromBytes(curve(), scalar.Bytes())
+
 	return &KeyPair{
 		(*PrivateKey)(ecdsaKey),
 		(*PublicKey)(&ecdsaKey.PublicKey),
 	}, nil
 }
 
+// randomScalar reads a random value from the provided source and maps it into
+// `[1, N-1]` range, where `N` is the order of the curve. Additional 64 bits are
+// read over the curve bit size so that the bias introduced by the modular
+// reduction is negligible.
+func randomScalar(rand io.Reader) (*big.Int, error) {
+	params := curve().Params()
+
+	bytes := make([]byte, params.BitSize/8+8)
+	if _, err := io.ReadFull(rand, bytes); err != nil {
+		return nil, err
+	}
+
+	one := big.NewInt(1)
+	scalar := new(big.Int).SetBytes(bytes)
+	scalar.Mod(scalar, new(big.Int).Sub(params.N, one))
+	scalar.Add(scalar, one)
+
+	return scalar, nil
+}
+
 // IsKeyMatching verifies if private key is valid for given public key.
 // It checks if public key equals `g^privateKey`, where `g` is a base point of
 // the curve.
@@ -73,3 +102,13 @@ func (pk *PrivateKey) Marshal() []byte {
 func (pk *PublicKey) Marshal() []byte {
 	return (*btcec.PublicKey)(pk).SerializeCompressed()
 }
+
+// Zeroize overwrites the private key scalar in memory with zeros. The key
+// must not be used after it was zeroized.
+func (pk *PrivateKey) Zeroize() {
+	words := pk.D.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	pk.D.SetInt64(0)
+}
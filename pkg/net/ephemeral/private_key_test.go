@@ -1,12 +1,15 @@
 package ephemeral
 
 import (
+	"bytes"
+	"crypto/rand"
+	mrand "math/rand"
 	"reflect"
 	"testing"
 )
 
 func TestMarshalUnmarshalPublicKey(t *testing.T) {
-	keyPair, err := GenerateKeyPair()
+	keyPair, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -24,7 +27,7 @@ func TestMarshalUnmarshalPublicKey(t *testing.T) {
 }
 
 func TestMarshalUnmarshalPrivateKey(t *testing.T) {
-	keyPair, err := GenerateKeyPair()
+	keyPair, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -38,11 +41,11 @@ func TestMarshalUnmarshalPrivateKey(t *testing.T) {
 }
 
 func TestIsKeyMatching(t *testing.T) {
-	keyPair1, err := GenerateKeyPair()
+	keyPair1, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
-	keyPair2, err := GenerateKeyPair()
+	keyPair2, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -57,3 +60,52 @@ func TestIsKeyMatching(t *testing.T) {
 		t.Fatal("private key matches wrong public key")
 	}
 }
+
+func TestGenerateKeyPairFromSeed(t *testing.T) {
+	seed := int64(1337)
+
+	keyPair1, err := GenerateKeyPair(mrand.New(mrand.NewSource(seed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPair2, err := GenerateKeyPair(mrand.New(mrand.NewSource(seed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(keyPair1.PrivateKey.Marshal(), keyPair2.PrivateKey.Marshal()) {
+		t.Fatal("private keys generated from the same seed do not match")
+	}
+	if !bytes.Equal(keyPair1.PublicKey.Marshal(), keyPair2.PublicKey.Marshal()) {
+		t.Fatal("public keys generated from the same seed do not match")
+	}
+
+	keyPair3, err := GenerateKeyPair(mrand.New(mrand.NewSource(seed + 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(keyPair1.PrivateKey.Marshal(), keyPair3.PrivateKey.Marshal()) {
+		t.Fatal("private keys generated from different seeds match")
+	}
+}
+
+func TestZeroizePrivateKey(t *testing.T) {
+	keyPair, err := GenerateKeyPair(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	words := keyPair.PrivateKey.D.Bits()
+
+	keyPair.PrivateKey.Zeroize()
+
+	if keyPair.PrivateKey.D.Sign() != 0 {
+		t.Fatalf("private key not zeroized: [%v]", keyPair.PrivateKey.D)
+	}
+	for _, word := range words {
+		if word != 0 {
+			t.Fatal("private key memory not overwritten")
+		}
+	}
+}
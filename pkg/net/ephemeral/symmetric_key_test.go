@@ -1,6 +1,7 @@
 package ephemeral
 
 import (
+	"crypto/rand"
 	"fmt"
 	"reflect"
 	"testing"
@@ -87,12 +88,12 @@ func TestGracefullyHandleBrokenCipher(t *testing.T) {
 }
 
 func newEcdhSymmetricKey() (*SymmetricEcdhKey, error) {
-	keyPair1, err := GenerateKeyPair()
+	keyPair1, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		return nil, err
 	}
 
-	keyPair2, err := GenerateKeyPair()
+	keyPair2, err := GenerateKeyPair(rand.Reader)
 	if err != nil {
 		return nil, err
 	}
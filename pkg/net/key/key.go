@@ -3,6 +3,7 @@ package key
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"fmt"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/keep-network/keep-core/pkg/operator"
@@ -57,6 +58,28 @@ func NetworkPubKeyToChainAddress(publicKey *NetworkPublic) string {
 	return operator.PubkeyToAddress(*ecdsaKey).String()
 }
 
+// NetworkKeyToChainAddress derives the chain account address of the operator
+// from its static network private key, in a string format. Since the static
+// network key is the operator key, the address is the same as the one used
+// to sign transactions and to identify the operator's stake on-chain.
+// An error is returned if the public key can not be derived from the private
+// key.
+func NetworkKeyToChainAddress(privateKey *NetworkPrivate) (string, error) {
+	if privateKey == nil {
+		return "", fmt.Errorf("network private key is nil")
+	}
+
+	publicKey, ok := privateKey.GetPublic().(*NetworkPublic)
+	if !ok {
+		return "", fmt.Errorf(
+			"unexpected network public key type [%T]",
+			privateKey.GetPublic(),
+		)
+	}
+
+	return NetworkPubKeyToChainAddress(publicKey), nil
+}
+
 // Marshal takes a network public key, converts it into an ecdsa
 // public key, and uses go's standard library elliptic marshal method to
 // convert the public key into a slice of bytes in the correct format for the key
@@ -1,6 +1,8 @@
 package key
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -154,3 +156,43 @@ func TestNetworkPubKeyToAddress(t *testing.T) {
 		)
 	}
 }
+
+func TestNetworkKeyToChainAddress(t *testing.T) {
+	staticPrivateKey, err := crypto.HexToECDSA(
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	networkPrivateKey, _ := OperatorKeyToNetworkKey(
+		staticPrivateKey, &staticPrivateKey.PublicKey,
+	)
+
+	expectedAddress := "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"
+	address, err := NetworkKeyToChainAddress(networkPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expectedAddress != address {
+		t.Errorf(
+			"unexpected address\nexpected: %v\nactual:   %v",
+			expectedAddress,
+			address,
+		)
+	}
+}
+
+func TestNetworkKeyToChainAddressNilKey(t *testing.T) {
+	_, err := NetworkKeyToChainAddress(nil)
+
+	expectedError := fmt.Errorf("network private key is nil")
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			expectedError,
+			err,
+		)
+	}
+}